@@ -0,0 +1,52 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1beta1
+
+import "testing"
+
+func TestBuilderJobTemplateGetTTLSecondsAfterFinished(t *testing.T) {
+	tests := map[string]struct {
+		template *BuilderJobTemplate
+		want     int32
+	}{
+		"nil template defaults to one hour": {
+			template: nil,
+			want:     defaultBuilderJobTTLSecondsAfterFinished,
+		},
+		"unset TTL defaults to one hour": {
+			template: &BuilderJobTemplate{},
+			want:     defaultBuilderJobTTLSecondsAfterFinished,
+		},
+		"explicit TTL overrides the default": {
+			template: &BuilderJobTemplate{TTLSecondsAfterFinished: int32Ptr(60)},
+			want:     60,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tt.template.GetTTLSecondsAfterFinished(); got != tt.want {
+				t.Errorf("GetTTLSecondsAfterFinished() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
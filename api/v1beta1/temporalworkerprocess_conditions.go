@@ -0,0 +1,116 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+const (
+	// ReconcileSuccess is the condition type used when the worker process reconciliation succeeded.
+	ReconcileSuccess = "ReconcileSuccess"
+	// ReconcileError is the condition type used when the worker process reconciliation failed.
+	ReconcileError = "ReconcileError"
+	// WorkerProcessReady is the condition type used to report the worker process readiness.
+	WorkerProcessReady = "Ready"
+	// WorkerProcessSuspended is the condition type used to report that the worker process
+	// reconciliation or dispatch has been suspended by the user.
+	WorkerProcessSuspended = "Suspended"
+	// WorkerProcessDraining is the condition type used to report that the worker process is
+	// being drained before its resources are deleted.
+	WorkerProcessDraining = "Draining"
+
+	// ReconcileSuccessReason is set when the worker process has been successfully reconciled.
+	ReconcileSuccessReason = "ReconcileSuccess"
+	// ReconcileErrorReason is set when the worker process reconciliation failed for an unspecified reason.
+	ReconcileErrorReason = "ReconcileError"
+	// ResourcesReconciliationFailedReason is set when the worker process resources reconciliation failed.
+	ResourcesReconciliationFailedReason = "ResourcesReconciliationFailed"
+	// ServicesReadyReason is set when the worker process deployment is ready.
+	ServicesReadyReason = "ServicesReady"
+	// ServicesNotReadyReason is set when the worker process deployment is not ready yet.
+	ServicesNotReadyReason = "ServicesNotReady"
+	// ReconciliationSuspendedReason is set when the worker process reconciliation has been suspended by the user.
+	ReconciliationSuspendedReason = "ReconciliationSuspended"
+	// DeploymentSuspendedReason is set when the worker process deployment has been scaled down because of user suspension.
+	DeploymentSuspendedReason = "DeploymentSuspended"
+	// DrainingReason is set while the worker process deployment is being drained before deletion.
+	DrainingReason = "Draining"
+	// StaleResourcesPrunedReason is set when the set of applied resources changed: new resources
+	// were applied, or resources no longer part of the worker process spec were garbage
+	// collected (or kept, when PreserveResourcesOnDeletion is set).
+	StaleResourcesPrunedReason = "StaleResourcesPruned"
+)
+
+// SetTemporalWorkerProcessReconcileSuccess sets the ReconcileSuccess condition on the worker process.
+func SetTemporalWorkerProcessReconcileSuccess(w *TemporalWorkerProcess, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&w.Status.Conditions, metav1.Condition{
+		Type:               ReconcileSuccess,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: w.GetGeneration(),
+	})
+	meta.RemoveStatusCondition(&w.Status.Conditions, ReconcileError)
+}
+
+// SetTemporalWorkerProcessReconcileError sets the ReconcileError condition on the worker process.
+func SetTemporalWorkerProcessReconcileError(w *TemporalWorkerProcess, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&w.Status.Conditions, metav1.Condition{
+		Type:               ReconcileError,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: w.GetGeneration(),
+	})
+	meta.RemoveStatusCondition(&w.Status.Conditions, ReconcileSuccess)
+}
+
+// SetTemporalWorkerProcessReady sets the Ready condition on the worker process.
+func SetTemporalWorkerProcessReady(w *TemporalWorkerProcess, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&w.Status.Conditions, metav1.Condition{
+		Type:               WorkerProcessReady,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: w.GetGeneration(),
+	})
+}
+
+// SetTemporalWorkerProcessSuspended sets the Suspended condition on the worker process.
+func SetTemporalWorkerProcessSuspended(w *TemporalWorkerProcess, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&w.Status.Conditions, metav1.Condition{
+		Type:               WorkerProcessSuspended,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: w.GetGeneration(),
+	})
+}
+
+// SetTemporalWorkerProcessDraining sets the Draining condition on the worker process.
+func SetTemporalWorkerProcessDraining(w *TemporalWorkerProcess, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&w.Status.Conditions, metav1.Condition{
+		Type:               WorkerProcessDraining,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: w.GetGeneration(),
+	})
+}
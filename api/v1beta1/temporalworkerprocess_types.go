@@ -0,0 +1,415 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1beta1
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// TemporalWorkerProcessSpec defines the desired state of TemporalWorkerProcess.
+type TemporalWorkerProcessSpec struct {
+	// ClusterRef is a reference to the TemporalCluster the worker process connects to.
+	ClusterRef TemporalClusterReference `json:"clusterRef"`
+	// Template defines the base pod spec used to run the worker process.
+	Template corev1.PodTemplateSpec `json:"template"`
+	// Builder allows configuring the worker process builder job.
+	// +optional
+	Builder WorkerProcessBuilder `json:"builder,omitempty"`
+	// Replicas is the number of desired worker process replicas.
+	// +optional
+	// +kubebuilder:default=1
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Version defines the worker process version.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// Suspension allows pausing the reconciliation and/or the dispatch of this worker process
+	// without deleting the resource.
+	// +optional
+	Suspension *WorkerProcessSuspension `json:"suspension,omitempty"`
+	// TerminationGracePolicy controls how the worker process is drained before its resources
+	// are deleted.
+	// +optional
+	TerminationGracePolicy *TerminationGracePolicy `json:"terminationGracePolicy,omitempty"`
+	// PreStopHook, when set, is called against the worker process before it's scaled down so it
+	// can gracefully shut down: finish in-flight activities or release them back to the task queue.
+	// +optional
+	PreStopHook *WorkerPreStopHook `json:"preStopHook,omitempty"`
+	// PreserveResourcesOnDeletion, when true, keeps resources that became stale after a spec
+	// change (e.g. a renamed builder) instead of garbage collecting them.
+	// +optional
+	PreserveResourcesOnDeletion bool `json:"preserveResourcesOnDeletion,omitempty"`
+	// RolloutStrategy controls how a new worker process version is rolled out. Defaults to
+	// RollingUpdate, which simply updates the existing Deployment in place.
+	// +optional
+	RolloutStrategy *RolloutStrategy `json:"rolloutStrategy,omitempty"`
+	// Placement, when set, fans the worker process out to one or more remote clusters instead of
+	// reconciling its resources locally. The referenced TemporalCluster can still live on the
+	// operator's own cluster.
+	// +optional
+	Placement *Placement `json:"placement,omitempty"`
+}
+
+// Placement selects the remote clusters a TemporalWorkerProcess is dispatched to.
+type Placement struct {
+	// ClusterSelector selects target clusters by label, matched against each target's
+	// ClusterDispatchTarget.Labels.
+	// +optional
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+	// Clusters explicitly lists the target clusters to dispatch the worker process to.
+	// +optional
+	Clusters []ClusterDispatchTarget `json:"clusters,omitempty"`
+}
+
+// ClusterDispatchTarget identifies one remote cluster the worker process is dispatched to.
+type ClusterDispatchTarget struct {
+	// Name identifies the target cluster. It's used as the Work object's namespace suffix and in
+	// TemporalWorkerProcessStatus.Clusters.
+	Name string `json:"name"`
+	// Labels are matched against Placement.ClusterSelector.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// KubeconfigSecretRef references the secret holding the kubeconfig used to reach this
+	// cluster's API server.
+	KubeconfigSecretRef corev1.LocalObjectReference `json:"kubeconfigSecretRef"`
+}
+
+// RolloutStrategyType is the type of rollout strategy used when the worker process version changes.
+type RolloutStrategyType string
+
+const (
+	// RollingUpdateRolloutStrategyType updates the existing Deployment in place, relying on the
+	// Deployment controller's own rolling update.
+	RollingUpdateRolloutStrategyType RolloutStrategyType = "RollingUpdate"
+	// BlueGreenRolloutStrategyType provisions a second Deployment running the new version,
+	// promoting it once ready and scaling down the old one.
+	BlueGreenRolloutStrategyType RolloutStrategyType = "BlueGreen"
+	// CanaryRolloutStrategyType provisions a second Deployment running the new version alongside
+	// the stable one, ramping it up through a sequence of steps.
+	CanaryRolloutStrategyType RolloutStrategyType = "Canary"
+)
+
+// RolloutStrategy defines how a new worker process version is rolled out.
+type RolloutStrategy struct {
+	// Type is the rollout strategy used. Defaults to RollingUpdate.
+	// +optional
+	// +kubebuilder:default=RollingUpdate
+	Type RolloutStrategyType `json:"type,omitempty"`
+	// BlueGreen configures the BlueGreen rollout strategy. Only used when Type is BlueGreen.
+	// +optional
+	BlueGreen *BlueGreenRolloutStrategy `json:"blueGreen,omitempty"`
+	// Canary configures the Canary rollout strategy. Only used when Type is Canary.
+	// +optional
+	Canary *CanaryRolloutStrategy `json:"canary,omitempty"`
+}
+
+// BlueGreenRolloutStrategy configures the BlueGreen rollout strategy.
+type BlueGreenRolloutStrategy struct {
+	// PromotionPolicy controls when the new ("green") Deployment is promoted to replace the old
+	// ("blue") one.
+	// +optional
+	PromotionPolicy PromotionPolicy `json:"promotionPolicy,omitempty"`
+}
+
+// CanaryRolloutStrategy configures the Canary rollout strategy.
+type CanaryRolloutStrategy struct {
+	// Steps describe the sequence of replica weights the canary Deployment ramps through. Both
+	// versions poll the same Temporal task queue, so Temporal's own load balancing splits tasks
+	// between them proportionally to their replica counts.
+	Steps []CanaryStep `json:"steps,omitempty"`
+}
+
+// CanaryStep is one step of a canary rollout.
+type CanaryStep struct {
+	// SetWeight is the percentage, 0-100, of replicas running the new version at this step.
+	SetWeight int32 `json:"setWeight"`
+	// Pause is how long the controller waits at this step before moving to the next one, counted
+	// cumulatively from the secondary Deployment's creation. When unset, the rollout stays at
+	// this step and later steps are not reached.
+	// +optional
+	Pause *metav1.Duration `json:"pause,omitempty"`
+}
+
+// PromotionPolicy controls when a BlueGreen rollout is promoted. Canary rollouts don't use it:
+// they're promoted once their steps reach the full replica count.
+type PromotionPolicy struct {
+	// Manual, when true, requires the user to set the
+	// `temporal.io/promote: "true"` annotation on the TemporalWorkerProcess to promote the rollout.
+	// +optional
+	Manual bool `json:"manual,omitempty"`
+	// ReadinessTimeout, when set, delays automatic promotion until the new Deployment is ready
+	// and at least this long has passed since it was created. Ignored when Manual is true.
+	// +optional
+	ReadinessTimeout *metav1.Duration `json:"readinessTimeout,omitempty"`
+}
+
+// PromoteAnnotation is set by the user on the TemporalWorkerProcess to approve a manual
+// promotion of a BlueGreen rollout.
+const PromoteAnnotation = "temporal.io/promote"
+
+// TerminationGracePolicy controls how long the controller waits for a worker process to drain
+// before deleting its resources.
+type TerminationGracePolicy struct {
+	// Timeout bounds how long the controller waits for the worker process Deployment to finish
+	// draining before proceeding with deletion regardless of in-flight tasks.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// SkipDrain, when true, deletes the worker process resources immediately without draining.
+	// +optional
+	SkipDrain bool `json:"skipDrain,omitempty"`
+}
+
+// GetTimeout returns the configured drain timeout, or 0 if unset.
+func (t *TerminationGracePolicy) GetTimeout() time.Duration {
+	if t == nil || t.Timeout == nil {
+		return 0
+	}
+	return t.Timeout.Duration
+}
+
+// WorkerPreStopHook defines a hook called on the worker process before it's drained.
+type WorkerPreStopHook struct {
+	// HTTP configures an HTTP pre-stop hook.
+	// +optional
+	HTTP *HTTPPreStopHook `json:"http,omitempty"`
+}
+
+// HTTPPreStopHook calls an HTTP endpoint exposed by the worker process before it's drained.
+type HTTPPreStopHook struct {
+	// URL is the HTTP endpoint called before the worker process is scaled down.
+	URL string `json:"url"`
+}
+
+// WorkerProcessSuspension defines how the worker process reconciliation and dispatch can be suspended.
+type WorkerProcessSuspension struct {
+	// Reconcile, when true, makes the controller skip reconciliation entirely for this worker
+	// process, leaving every managed resource untouched.
+	// +optional
+	Reconcile bool `json:"reconcile,omitempty"`
+	// Deployment, when true, keeps reconciling the configmap and builder artifacts but scales
+	// the managed Deployment down to zero replicas.
+	// +optional
+	Deployment bool `json:"deployment,omitempty"`
+}
+
+// WorkerProcessBuilder defines the configuration for the worker process builder job.
+type WorkerProcessBuilder struct {
+	// Enabled defines if the operator should build the worker process image using a job.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Image is the image used to build the worker process.
+	// +optional
+	Image string `json:"image,omitempty"`
+	// JobTemplates allows overriding the pod spec of the builder jobs, keyed by job name (see
+	// resource.GetWorkerProcessJobs for the available names).
+	// +optional
+	JobTemplates map[string]BuilderJobTemplate `json:"jobTemplates,omitempty"`
+}
+
+// defaultBuilderJobTTLSecondsAfterFinished is used for builder jobs whose template doesn't set
+// TTLSecondsAfterFinished, so successful builder jobs get cleaned up instead of accumulating.
+const defaultBuilderJobTTLSecondsAfterFinished int32 = 3600
+
+// BuilderJobTemplate overrides fields of the pod spec and job spec used to run a worker process
+// builder job.
+type BuilderJobTemplate struct {
+	// TTLSecondsAfterFinished limits the lifetime of a finished builder job. Defaults to 3600 (1h)
+	// when unset so successful jobs don't accumulate.
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+	// BackoffLimit is the number of retries before the builder job is considered failed.
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// ActiveDeadlineSeconds bounds how long the builder job is allowed to run.
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+	// Resources are the compute resource requirements for the builder container.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	// NodeSelector constrains the nodes the builder job's pod can be scheduled on.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations are applied to the builder job's pod.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Affinity is applied to the builder job's pod.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// ImagePullSecrets are used to pull the builder job's image.
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// Env defines additional environment variables set on the builder container.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// VolumeMounts defines additional volume mounts set on the builder container.
+	// +optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	// Volumes defines additional volumes added to the builder job's pod.
+	// +optional
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+}
+
+// GetTTLSecondsAfterFinished returns the configured TTL, defaulting to
+// defaultBuilderJobTTLSecondsAfterFinished when unset.
+func (t *BuilderJobTemplate) GetTTLSecondsAfterFinished() int32 {
+	if t == nil || t.TTLSecondsAfterFinished == nil {
+		return defaultBuilderJobTTLSecondsAfterFinished
+	}
+	return *t.TTLSecondsAfterFinished
+}
+
+// BuilderEnabled returns true if the worker process builder is enabled.
+func (w *WorkerProcessBuilder) BuilderEnabled() bool {
+	return w.Enabled != nil && *w.Enabled
+}
+
+// TemporalClusterReference references a TemporalCluster object.
+type TemporalClusterReference struct {
+	// Name is the name of the referenced TemporalCluster.
+	Name string `json:"name"`
+	// Namespace is the namespace of the referenced TemporalCluster.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// TemporalWorkerProcessStatus defines the observed state of TemporalWorkerProcess.
+type TemporalWorkerProcessStatus struct {
+	// Conditions represent the latest available observations of the worker process state.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Ready defines if the worker process deployment is ready.
+	// +optional
+	Ready bool `json:"ready,omitempty"`
+	// Version holds the currently reconciled version of the worker process.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// AppliedResources lists the resources successfully applied during the last reconciliation.
+	// It's used to garbage collect resources that become stale after a spec change.
+	// +optional
+	AppliedResources []AppliedResourceMeta `json:"appliedResources,omitempty"`
+	// Versions reports the readiness of every worker process version currently deployed. It's
+	// left empty for RollingUpdate, and holds up to two entries (stable, new) for BlueGreen and
+	// Canary.
+	// +optional
+	Versions []WorkerProcessVersion `json:"versions,omitempty"`
+	// Clusters reports per-cluster readiness when Spec.Placement dispatches the worker process
+	// to one or more remote clusters.
+	// +optional
+	Clusters []ClusterDispatchStatus `json:"clusters,omitempty"`
+	// BuiltJobs tracks, per builder job name, the ObservedGeneration at which that job last
+	// completed successfully. It gates re-creation of a builder job: once its TTL expires and
+	// Kubernetes garbage collects it, the controller must not re-run the build just because the
+	// job object is gone.
+	// +optional
+	BuiltJobs map[string]int64 `json:"builtJobs,omitempty"`
+}
+
+// ClusterDispatchStatus reports the health of a worker process dispatched to one remote cluster.
+type ClusterDispatchStatus struct {
+	// Name is the target cluster's name, as set in Spec.Placement.Clusters.
+	Name string `json:"name"`
+	// Ready is true once every resource applied to this cluster reports healthy.
+	Ready bool `json:"ready"`
+	// AppliedResources is the number of resources dispatched to this cluster, including those
+	// whose apply failed; failed ones are reported as not healthy.
+	AppliedResources int32 `json:"appliedResources"`
+	// Message gives a human-readable reason when Ready is false.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// WorkerProcessVersionPhase describes the rollout phase of a worker process version.
+type WorkerProcessVersionPhase string
+
+const (
+	// WorkerProcessVersionPhaseStable is the currently promoted, serving version.
+	WorkerProcessVersionPhaseStable WorkerProcessVersionPhase = "Stable"
+	// WorkerProcessVersionPhaseProgressing is a new version being rolled out (BlueGreen or Canary).
+	WorkerProcessVersionPhaseProgressing WorkerProcessVersionPhase = "Progressing"
+	// WorkerProcessVersionPhasePromoting is a new version that met its promotion policy and is
+	// about to become stable.
+	WorkerProcessVersionPhasePromoting WorkerProcessVersionPhase = "Promoting"
+	// WorkerProcessVersionPhaseFailed is a new version rolled back because it was reported unhealthy.
+	WorkerProcessVersionPhaseFailed WorkerProcessVersionPhase = "Failed"
+)
+
+// WorkerProcessVersion reports the readiness of one worker process version.
+type WorkerProcessVersion struct {
+	// Name identifies the version, e.g. the Deployment name running it.
+	Name string `json:"name"`
+	// Image is the worker process image running this version.
+	Image string `json:"image"`
+	// Replicas is the number of replicas configured for this version.
+	Replicas int32 `json:"replicas"`
+	// Ready is the number of ready replicas for this version.
+	Ready int32 `json:"ready"`
+	// Phase is the rollout phase of this version.
+	Phase WorkerProcessVersionPhase `json:"phase"`
+}
+
+// AppliedResourceMeta identifies a resource applied by the controller on behalf of a
+// TemporalWorkerProcess.
+type AppliedResourceMeta struct {
+	// APIVersion is the applied resource's API version.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the applied resource's kind.
+	Kind string `json:"kind"`
+	// Namespace is the applied resource's namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the applied resource's name.
+	Name string `json:"name"`
+	// UID is the applied resource's UID, used to detect recreation under the same name.
+	// +optional
+	UID types.UID `json:"uid,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:shortName=temporalwp
+
+// TemporalWorkerProcess defines a temporal worker process deployment.
+type TemporalWorkerProcess struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TemporalWorkerProcessSpec   `json:"spec,omitempty"`
+	Status TemporalWorkerProcessStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TemporalWorkerProcessList contains a list of TemporalWorkerProcess.
+type TemporalWorkerProcessList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TemporalWorkerProcess `json:"items"`
+}
+
+// IsSuspended returns true if the worker process reconciliation has been suspended by the user.
+func (w *TemporalWorkerProcess) IsSuspended() bool {
+	return w.Spec.Suspension != nil && w.Spec.Suspension.Reconcile
+}
+
+// IsDeploymentSuspended returns true if the worker process deployment has been suspended by the user.
+func (w *TemporalWorkerProcess) IsDeploymentSuspended() bool {
+	return w.Spec.Suspension != nil && w.Spec.Suspension.Deployment
+}
@@ -0,0 +1,62 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1beta1
+
+import "testing"
+
+func TestIsSuspended(t *testing.T) {
+	tests := map[string]struct {
+		suspension *WorkerProcessSuspension
+		want       bool
+	}{
+		"no suspension set":                      {suspension: nil, want: false},
+		"suspension set but reconcile false":     {suspension: &WorkerProcessSuspension{}, want: false},
+		"reconcile suspended":                    {suspension: &WorkerProcessSuspension{Reconcile: true}, want: true},
+		"deployment suspended but not reconcile": {suspension: &WorkerProcessSuspension{Deployment: true}, want: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			worker := &TemporalWorkerProcess{Spec: TemporalWorkerProcessSpec{Suspension: tt.suspension}}
+			if got := worker.IsSuspended(); got != tt.want {
+				t.Errorf("IsSuspended() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsDeploymentSuspended(t *testing.T) {
+	tests := map[string]struct {
+		suspension *WorkerProcessSuspension
+		want       bool
+	}{
+		"no suspension set":                      {suspension: nil, want: false},
+		"suspension set but deployment false":    {suspension: &WorkerProcessSuspension{}, want: false},
+		"deployment suspended":                   {suspension: &WorkerProcessSuspension{Deployment: true}, want: true},
+		"reconcile suspended but not deployment": {suspension: &WorkerProcessSuspension{Reconcile: true}, want: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			worker := &TemporalWorkerProcess{Spec: TemporalWorkerProcessSpec{Suspension: tt.suspension}}
+			if got := worker.IsDeploymentSuspended(); got != tt.want {
+				t.Errorf("IsDeploymentSuspended() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
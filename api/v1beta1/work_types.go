@@ -0,0 +1,129 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// WorkSpec defines a manifest bundle dispatched to a remote cluster, following the
+// sigs.k8s.io/work-api model: the operator writes a Work object on its own cluster as a record
+// of the dispatched manifests, then applies those resources on the remote cluster itself,
+// through a client built from the target's KubeconfigSecretRef. No controller on the remote
+// cluster reads the Work object.
+type WorkSpec struct {
+	// Workload holds the manifests to apply on the remote cluster.
+	Workload WorkloadTemplate `json:"workload,omitempty"`
+}
+
+// WorkloadTemplate holds the manifests of a Work object.
+type WorkloadTemplate struct {
+	// Manifests is the list of resources, each serialized as a raw Kubernetes object, to apply on
+	// the remote cluster.
+	// +optional
+	Manifests []runtime.RawExtension `json:"manifests,omitempty"`
+}
+
+// WorkStatus reports the outcome of the last apply attempt for a Work object.
+type WorkStatus struct {
+	// Conditions represent the latest available observations of the Work's apply state.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// Work is a bundle of manifests dispatched to a single remote cluster on behalf of a
+// TemporalWorkerProcess using Spec.Placement.
+type Work struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkSpec   `json:"spec,omitempty"`
+	Status WorkStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// WorkList contains a list of Work.
+type WorkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Work `json:"items"`
+}
+
+// AppliedWorkSpec references the Work object this AppliedWork reports on.
+type AppliedWorkSpec struct {
+	// WorkName is the name of the Work object applied.
+	WorkName string `json:"workName"`
+	// WorkNamespace is the namespace of the Work object applied.
+	WorkNamespace string `json:"workNamespace"`
+}
+
+// AppliedWorkStatus reports, per manifest, whether it was applied successfully and is healthy.
+type AppliedWorkStatus struct {
+	// AppliedResources lists every resource applied from the Work object's manifests, along with
+	// its health.
+	// +optional
+	AppliedResources []AppliedResourceStatus `json:"appliedResources,omitempty"`
+}
+
+// AppliedResourceStatus reports the outcome of applying a single manifest from a Work object.
+type AppliedResourceStatus struct {
+	AppliedResourceMeta `json:",inline"`
+	// Healthy is true once the resource reports ready (e.g. a Deployment with all replicas ready).
+	Healthy bool `json:"healthy"`
+	// Message gives a human-readable reason when Healthy is false.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// AppliedWork reports the outcome of applying a Work object's manifests. For a worker process
+// using Spec.Placement, the TemporalWorkerProcessReconciler writes it next to the Work object,
+// on the operator's own cluster.
+type AppliedWork struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AppliedWorkSpec   `json:"spec,omitempty"`
+	Status AppliedWorkStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AppliedWorkList contains a list of AppliedWork.
+type AppliedWorkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AppliedWork `json:"items"`
+}
+
+// IsHealthy reports whether every resource applied from this Work reports healthy.
+func (w *AppliedWork) IsHealthy() bool {
+	for _, res := range w.Status.AppliedResources {
+		if !res.Healthy {
+			return false
+		}
+	}
+	return true
+}
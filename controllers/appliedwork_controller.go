@@ -0,0 +1,156 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/alexandrevilain/temporal-operator/api/v1beta1"
+)
+
+// AppliedWorkReconciler applies the manifests of Work objects dispatched by
+// TemporalWorkerProcessReconciler and reports their health back as an AppliedWork object. It's
+// meant to run against a target cluster's client, so a worker process can fan out across many
+// data-plane clusters while being defined on a single one.
+type AppliedWorkReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+//+kubebuilder:rbac:groups=temporal.io,resources=works,verbs=get;list;watch
+//+kubebuilder:rbac:groups=temporal.io,resources=appliedworks,verbs=get;list;watch;create;update;patch
+
+// Reconcile applies every manifest of the Work object and records their health on its AppliedWork.
+func (r *AppliedWorkReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	work := &v1beta1.Work{}
+	if err := r.Get(ctx, req.NamespacedName, work); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	logger.Info("Applying work manifests", "name", work.Name, "namespace", work.Namespace, "count", len(work.Spec.Workload.Manifests))
+
+	appliedResources := make([]v1beta1.AppliedResourceStatus, 0, len(work.Spec.Workload.Manifests))
+	for _, manifest := range work.Spec.Workload.Manifests {
+		status, err := r.applyManifest(ctx, manifest)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		appliedResources = append(appliedResources, status)
+	}
+
+	appliedWork := &v1beta1.AppliedWork{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      work.Name,
+			Namespace: work.Namespace,
+		},
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, appliedWork, func() error {
+		appliedWork.Spec.WorkName = work.Name
+		appliedWork.Spec.WorkNamespace = work.Namespace
+		appliedWork.Status.AppliedResources = appliedResources
+		return nil
+	})
+
+	return reconcile.Result{}, err
+}
+
+// applyManifest applies a single raw manifest and reports whether the resulting object is
+// healthy.
+func (r *AppliedWorkReconciler) applyManifest(ctx context.Context, manifest runtime.RawExtension) (v1beta1.AppliedResourceStatus, error) {
+	obj := &unstructured.Unstructured{}
+	if err := json.Unmarshal(manifest.Raw, obj); err != nil {
+		return v1beta1.AppliedResourceStatus{}, err
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, obj, func() error {
+		return nil
+	})
+	if err != nil {
+		return v1beta1.AppliedResourceStatus{
+			AppliedResourceMeta: appliedResourceMetaFromUnstructured(obj),
+			Healthy:             false,
+			Message:             err.Error(),
+		}, nil
+	}
+
+	return v1beta1.AppliedResourceStatus{
+		AppliedResourceMeta: appliedResourceMetaFromUnstructured(obj),
+		Healthy:             isResourceHealthy(obj),
+	}, nil
+}
+
+func appliedResourceMetaFromUnstructured(obj *unstructured.Unstructured) v1beta1.AppliedResourceMeta {
+	gvk := obj.GroupVersionKind()
+	return v1beta1.AppliedResourceMeta{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+		UID:        obj.GetUID(),
+	}
+}
+
+// isResourceHealthy reports whether the applied resource is ready. Deployments are considered
+// healthy once every replica is ready; every other kind is considered healthy once applied.
+func isResourceHealthy(obj *unstructured.Unstructured) bool {
+	if obj.GroupVersionKind().Kind != "Deployment" {
+		return true
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, deployment); err != nil {
+		return false
+	}
+
+	return isDeploymentHealthy(deployment)
+}
+
+// isDeploymentHealthy reports whether every replica of the given Deployment is ready.
+func isDeploymentHealthy(deployment *appsv1.Deployment) bool {
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+
+	return deployment.Status.ReadyReplicas == desired
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AppliedWorkReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1beta1.Work{}).
+		Complete(r)
+}
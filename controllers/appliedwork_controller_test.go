@@ -0,0 +1,82 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func toUnstructuredWithKind(t *testing.T, obj runtime.Object, kind string) *unstructured.Unstructured {
+	t.Helper()
+
+	raw, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		t.Fatalf("failed to convert to unstructured: %v", err)
+	}
+
+	u := &unstructured.Unstructured{Object: raw}
+	u.SetAPIVersion("apps/v1")
+	u.SetKind(kind)
+	return u
+}
+
+func TestIsResourceHealthy(t *testing.T) {
+	replicas := func(n int32) *int32 { return &n }
+
+	tests := map[string]struct {
+		obj  *unstructured.Unstructured
+		want bool
+	}{
+		"non-deployment kinds are always healthy": {
+			obj:  toUnstructuredWithKind(t, &appsv1.ReplicaSet{}, "ReplicaSet"),
+			want: true,
+		},
+		"deployment with every replica ready": {
+			obj: toUnstructuredWithKind(t, &appsv1.Deployment{
+				Spec:   appsv1.DeploymentSpec{Replicas: replicas(3)},
+				Status: appsv1.DeploymentStatus{ReadyReplicas: 3},
+			}, "Deployment"),
+			want: true,
+		},
+		"deployment missing ready replicas": {
+			obj: toUnstructuredWithKind(t, &appsv1.Deployment{
+				Spec:   appsv1.DeploymentSpec{Replicas: replicas(3)},
+				Status: appsv1.DeploymentStatus{ReadyReplicas: 1},
+			}, "Deployment"),
+			want: false,
+		},
+		"deployment with unset replicas defaults to one desired": {
+			obj: toUnstructuredWithKind(t, &appsv1.Deployment{
+				Status: appsv1.DeploymentStatus{ReadyReplicas: 1},
+			}, "Deployment"),
+			want: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := isResourceHealthy(tt.obj); got != tt.want {
+				t.Errorf("isResourceHealthy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
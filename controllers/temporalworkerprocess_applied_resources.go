@@ -0,0 +1,106 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/alexandrevilain/temporal-operator/api/v1beta1"
+)
+
+// appliedResourceMetaFor builds the AppliedResourceMeta identifying the given resource, as it
+// was just applied to the cluster by CreateOrUpdate.
+func (r *TemporalWorkerProcessReconciler) appliedResourceMetaFor(res client.Object) (v1beta1.AppliedResourceMeta, error) {
+	gvks, _, err := r.Scheme.ObjectKinds(res)
+	if err != nil {
+		return v1beta1.AppliedResourceMeta{}, err
+	}
+	gvk := gvks[0]
+
+	return v1beta1.AppliedResourceMeta{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Namespace:  res.GetNamespace(),
+		Name:       res.GetName(),
+		UID:        res.GetUID(),
+	}, nil
+}
+
+// diffAppliedResources compares the resources applied during the previous reconciliation with
+// the ones applied during the current one, returning the resources that are new and the ones
+// that became stale (present before, no longer produced by any builder).
+func diffAppliedResources(previouslyApplied, currentlyApplied []v1beta1.AppliedResourceMeta) (newRes, staleRes []v1beta1.AppliedResourceMeta) {
+	current := make(map[string]struct{}, len(currentlyApplied))
+	for _, res := range currentlyApplied {
+		current[appliedResourceKey(res)] = struct{}{}
+	}
+
+	previous := make(map[string]struct{}, len(previouslyApplied))
+	for _, res := range previouslyApplied {
+		previous[appliedResourceKey(res)] = struct{}{}
+	}
+
+	for _, res := range currentlyApplied {
+		if _, ok := previous[appliedResourceKey(res)]; !ok {
+			newRes = append(newRes, res)
+		}
+	}
+
+	for _, res := range previouslyApplied {
+		if _, ok := current[appliedResourceKey(res)]; !ok {
+			staleRes = append(staleRes, res)
+		}
+	}
+
+	return newRes, staleRes
+}
+
+// appliedResourceKey uniquely identifies an applied resource by GVK, namespace and name,
+// ignoring mutating server-side fields such as resourceVersion or UID.
+func appliedResourceKey(res v1beta1.AppliedResourceMeta) string {
+	return fmt.Sprintf("%s/%s/%s/%s", res.APIVersion, res.Kind, res.Namespace, res.Name)
+}
+
+// pruneStaleResources deletes every resource that is no longer produced by the worker process
+// builders, e.g. after a builder has been renamed or removed from the spec.
+func (r *TemporalWorkerProcessReconciler) pruneStaleResources(ctx context.Context, staleRes []v1beta1.AppliedResourceMeta) error {
+	for _, res := range staleRes {
+		gv, err := schema.ParseGroupVersion(res.APIVersion)
+		if err != nil {
+			return err
+		}
+
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gv.WithKind(res.Kind))
+		obj.SetNamespace(res.Namespace)
+		obj.SetName(res.Name)
+
+		err = r.Delete(ctx, obj, client.Preconditions{UID: &res.UID})
+		if err != nil && !apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+			return err
+		}
+	}
+
+	return nil
+}
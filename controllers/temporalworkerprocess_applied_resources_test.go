@@ -0,0 +1,96 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/alexandrevilain/temporal-operator/api/v1beta1"
+)
+
+func TestDiffAppliedResources(t *testing.T) {
+	deployment := v1beta1.AppliedResourceMeta{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "worker"}
+	configMap := v1beta1.AppliedResourceMeta{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "worker-builder-scripts"}
+	job := v1beta1.AppliedResourceMeta{APIVersion: "batch/v1", Kind: "Job", Namespace: "default", Name: "worker-builder"}
+
+	tests := map[string]struct {
+		previouslyApplied []v1beta1.AppliedResourceMeta
+		currentlyApplied  []v1beta1.AppliedResourceMeta
+		wantNew           []v1beta1.AppliedResourceMeta
+		wantStale         []v1beta1.AppliedResourceMeta
+	}{
+		"first reconcile, nothing previously applied": {
+			previouslyApplied: nil,
+			currentlyApplied:  []v1beta1.AppliedResourceMeta{deployment, configMap},
+			wantNew:           []v1beta1.AppliedResourceMeta{deployment, configMap},
+			wantStale:         nil,
+		},
+		"unchanged set": {
+			previouslyApplied: []v1beta1.AppliedResourceMeta{deployment, configMap},
+			currentlyApplied:  []v1beta1.AppliedResourceMeta{deployment, configMap},
+			wantNew:           nil,
+			wantStale:         nil,
+		},
+		"a builder is added": {
+			previouslyApplied: []v1beta1.AppliedResourceMeta{deployment},
+			currentlyApplied:  []v1beta1.AppliedResourceMeta{deployment, job},
+			wantNew:           []v1beta1.AppliedResourceMeta{job},
+			wantStale:         nil,
+		},
+		"a builder is removed": {
+			previouslyApplied: []v1beta1.AppliedResourceMeta{deployment, configMap, job},
+			currentlyApplied:  []v1beta1.AppliedResourceMeta{deployment, configMap},
+			wantNew:           nil,
+			wantStale:         []v1beta1.AppliedResourceMeta{job},
+		},
+		"a builder is swapped for another": {
+			previouslyApplied: []v1beta1.AppliedResourceMeta{deployment, job},
+			currentlyApplied:  []v1beta1.AppliedResourceMeta{deployment, configMap},
+			wantNew:           []v1beta1.AppliedResourceMeta{configMap},
+			wantStale:         []v1beta1.AppliedResourceMeta{job},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			newRes, staleRes := diffAppliedResources(tt.previouslyApplied, tt.currentlyApplied)
+			if !sameResources(newRes, tt.wantNew) {
+				t.Errorf("newRes = %v, want %v", newRes, tt.wantNew)
+			}
+			if !sameResources(staleRes, tt.wantStale) {
+				t.Errorf("staleRes = %v, want %v", staleRes, tt.wantStale)
+			}
+		})
+	}
+}
+
+// sameResources compares two AppliedResourceMeta slices ignoring order.
+func sameResources(got, want []v1beta1.AppliedResourceMeta) bool {
+	if len(got) != len(want) {
+		return false
+	}
+
+	sortedGot := append([]v1beta1.AppliedResourceMeta{}, got...)
+	sortedWant := append([]v1beta1.AppliedResourceMeta{}, want...)
+	sort.Slice(sortedGot, func(i, j int) bool { return appliedResourceKey(sortedGot[i]) < appliedResourceKey(sortedGot[j]) })
+	sort.Slice(sortedWant, func(i, j int) bool { return appliedResourceKey(sortedWant[i]) < appliedResourceKey(sortedWant[j]) })
+
+	return reflect.DeepEqual(sortedGot, sortedWant)
+}
@@ -0,0 +1,63 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/alexandrevilain/temporal-operator/api/v1beta1"
+)
+
+func TestJobAlreadyBuilt(t *testing.T) {
+	tests := map[string]struct {
+		generation int64
+		builtJobs  map[string]int64
+		want       bool
+	}{
+		"never built": {
+			generation: 1,
+			builtJobs:  nil,
+			want:       false,
+		},
+		"built for an older generation needs a rebuild": {
+			generation: 2,
+			builtJobs:  map[string]int64{"build": 1},
+			want:       false,
+		},
+		"built for the current generation, job object since TTL-deleted": {
+			generation: 1,
+			builtJobs:  map[string]int64{"build": 1},
+			want:       true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			worker := &v1beta1.TemporalWorkerProcess{
+				ObjectMeta: metav1.ObjectMeta{Generation: tt.generation},
+				Status:     v1beta1.TemporalWorkerProcessStatus{BuiltJobs: tt.builtJobs},
+			}
+
+			if got := jobAlreadyBuilt(worker, "build"); got != tt.want {
+				t.Errorf("jobAlreadyBuilt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -76,9 +76,31 @@ func (r *TemporalWorkerProcessReconciler) Reconcile(ctx context.Context, req ctr
 	// Check if the resource has been marked for deletion
 	if !worker.ObjectMeta.DeletionTimestamp.IsZero() {
 		logger.Info("Deleting worker process", "name", worker.Name)
+		if result, err := r.reconcileDelete(ctx, worker); !result.IsZero() || err != nil {
+			return result, err
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(worker, workerProcessDrainFinalizer) {
+		controllerutil.AddFinalizer(worker, workerProcessDrainFinalizer)
+		if err := r.Update(ctx, worker); err != nil {
+			logger.Error(err, "Can't add worker process drain finalizer")
+			return reconcile.Result{}, err
+		}
+		// As we updated the instance, another reconcile will be triggered.
 		return reconcile.Result{}, nil
 	}
 
+	if worker.IsSuspended() {
+		logger.Info("Worker process reconciliation is suspended, skipping", "name", worker.Name)
+		v1beta1.SetTemporalWorkerProcessSuspended(worker, metav1.ConditionTrue, v1beta1.ReconciliationSuspendedReason, "Worker process reconciliation is suspended")
+		r.Recorder.Event(worker, corev1.EventTypeNormal, v1beta1.ReconciliationSuspendedReason, "Worker process reconciliation is suspended")
+		return reconcile.Result{}, r.updateWorkerProcessStatus(ctx, worker)
+	}
+
+	v1beta1.SetTemporalWorkerProcessSuspended(worker, metav1.ConditionFalse, v1beta1.ReconciliationSuspendedReason, "")
+
 	// Set defaults on unfiled fields.
 	updated := r.reconcileDefaults(ctx, worker)
 	if updated {
@@ -105,6 +127,11 @@ func (r *TemporalWorkerProcessReconciler) Reconcile(ctx context.Context, req ctr
 				continue
 			}
 
+			if jobAlreadyBuilt(worker, job.Name) {
+				logger.Info("Worker process build job already succeeded for this generation, skipping", "name", job.Name)
+				continue
+			}
+
 			logger.Info("Checking for worker process builder job", "name", job.Name)
 			expectedJobBuilder := workerbuilder.NewWorkerProcessJobBuilder(worker, r.Scheme, job.Name, job.Command)
 
@@ -193,6 +220,14 @@ func (r *TemporalWorkerProcessReconciler) reconcileWorkerScriptsConfigmap(ctx co
 	return err
 }
 
+// jobAlreadyBuilt reports whether the named builder job already succeeded for the worker
+// process' current generation, so the controller doesn't re-run a build just because its job
+// object was garbage collected after TTLSecondsAfterFinished elapsed.
+func jobAlreadyBuilt(worker *v1beta1.TemporalWorkerProcess, jobName string) bool {
+	builtGeneration, ok := worker.Status.BuiltJobs[jobName]
+	return ok && builtGeneration == worker.Generation
+}
+
 func (r *TemporalWorkerProcessReconciler) handleErrorWithRequeue(ctx context.Context, worker *v1beta1.TemporalWorkerProcess, reason string, err error, requeueAfter time.Duration) (ctrl.Result, error) {
 	if reason == "" {
 		reason = v1beta1.ReconcileErrorReason
@@ -217,10 +252,20 @@ func (r *TemporalWorkerProcessReconciler) updateWorkerProcessStatus(ctx context.
 func (r *TemporalWorkerProcessReconciler) reconcileResources(ctx context.Context, temporalWorkerProcess *v1beta1.TemporalWorkerProcess, temporalCluster *v1beta1.TemporalCluster) error {
 	logger := log.FromContext(ctx)
 
+	if temporalWorkerProcess.Spec.Placement != nil {
+		return r.reconcileRemotePlacement(ctx, temporalWorkerProcess, temporalCluster)
+	}
+
+	rollout, err := r.planRollout(ctx, temporalWorkerProcess)
+	if err != nil {
+		return err
+	}
+
 	workerProcessBuilder := workerprocess.Builder{
 		Instance: temporalWorkerProcess,
 		Cluster:  temporalCluster,
 		Scheme:   r.Scheme,
+		Rollout:  rollout,
 	}
 
 	builders, err := workerProcessBuilder.ResourceBuilders()
@@ -230,6 +275,17 @@ func (r *TemporalWorkerProcessReconciler) reconcileResources(ctx context.Context
 
 	logger.Info("Retrieved builders", "count", len(builders))
 
+	if temporalWorkerProcess.IsDeploymentSuspended() {
+		logger.Info("Worker process deployment is suspended, scaling down", "name", temporalWorkerProcess.Name)
+		v1beta1.SetTemporalWorkerProcessSuspended(temporalWorkerProcess, metav1.ConditionTrue, v1beta1.DeploymentSuspendedReason, "Worker process deployment is suspended")
+		r.Recorder.Event(temporalWorkerProcess, corev1.EventTypeNormal, v1beta1.DeploymentSuspendedReason, "Worker process deployment is scaled down to 0 replicas")
+	}
+
+	currentlyApplied := make([]v1beta1.AppliedResourceMeta, 0, len(builders))
+
+	hasDeploymentReporter := false
+	allWorkerDeploymentsReady := true
+
 	for _, builder := range builders {
 		if comparer, ok := builder.(resource.Comparer); ok {
 			err := equality.Semantic.AddFunc(comparer.Equal)
@@ -251,6 +307,12 @@ func (r *TemporalWorkerProcessReconciler) reconcileResources(ctx context.Context
 			return err
 		}
 
+		appliedMeta, err := r.appliedResourceMetaFor(res)
+		if err != nil {
+			return err
+		}
+		currentlyApplied = append(currentlyApplied, appliedMeta)
+
 		reporter, ok := builder.(resource.WorkerProcessDeploymentReporter)
 		if !ok {
 			continue
@@ -262,9 +324,43 @@ func (r *TemporalWorkerProcessReconciler) reconcileResources(ctx context.Context
 		}
 
 		logger.Info("Reporting worker process status")
-		temporalWorkerProcess.Status.Ready = isWorkerDeploymentReady
+		hasDeploymentReporter = true
+		allWorkerDeploymentsReady = allWorkerDeploymentsReady && isWorkerDeploymentReady
+	}
+
+	if hasDeploymentReporter {
+		temporalWorkerProcess.Status.Ready = allWorkerDeploymentsReady
 	}
 
+	newRes, staleRes := diffAppliedResources(temporalWorkerProcess.Status.AppliedResources, currentlyApplied)
+
+	trackedResources := currentlyApplied
+	preservedStaleRes := len(staleRes) > 0 && temporalWorkerProcess.Spec.PreserveResourcesOnDeletion
+
+	if preservedStaleRes {
+		logger.Info("Skipping stale resources garbage collection", "count", len(staleRes))
+		// Keep preserved resources tracked so they can still be pruned later, if
+		// PreserveResourcesOnDeletion is turned off.
+		trackedResources = append(trackedResources, staleRes...)
+	} else if len(staleRes) > 0 {
+		if err := r.pruneStaleResources(ctx, staleRes); err != nil {
+			return err
+		}
+	}
+
+	if len(newRes) > 0 || len(staleRes) > 0 {
+		var msg string
+		if preservedStaleRes {
+			msg = fmt.Sprintf("preserved %d stale resource(s) (preserveResourcesOnDeletion is set), applied %d new resource(s)", len(staleRes), len(newRes))
+		} else {
+			msg = fmt.Sprintf("pruned %d stale resource(s), applied %d new resource(s)", len(staleRes), len(newRes))
+		}
+		r.Recorder.Event(temporalWorkerProcess, corev1.EventTypeNormal, v1beta1.StaleResourcesPrunedReason, msg)
+		v1beta1.SetTemporalWorkerProcessReconcileSuccess(temporalWorkerProcess, metav1.ConditionTrue, v1beta1.StaleResourcesPrunedReason, msg)
+	}
+
+	temporalWorkerProcess.Status.AppliedResources = trackedResources
+
 	if status.IsWorkerProcessReady(temporalWorkerProcess) {
 		v1beta1.SetTemporalWorkerProcessReady(temporalWorkerProcess, metav1.ConditionTrue, v1beta1.ServicesReadyReason, "")
 	} else {
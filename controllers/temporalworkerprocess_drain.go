@@ -0,0 +1,186 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/alexandrevilain/temporal-operator/api/v1beta1"
+)
+
+// workerProcessDrainFinalizer is set on every TemporalWorkerProcess so the controller gets a
+// chance to drain the worker before its resources are garbage collected.
+const workerProcessDrainFinalizer = "temporal.io/worker-process-drain"
+
+// defaultDrainPollInterval is the interval used while waiting for the worker process Deployment
+// to finish terminating its pods.
+const defaultDrainPollInterval = 2 * time.Second
+
+// reconcileDelete drains then deletes every resource owned by the worker process, in order,
+// before removing the drain finalizer.
+func (r *TemporalWorkerProcessReconciler) reconcileDelete(ctx context.Context, worker *v1beta1.TemporalWorkerProcess) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if !controllerutil.ContainsFinalizer(worker, workerProcessDrainFinalizer) {
+		return reconcile.Result{}, nil
+	}
+
+	policy := worker.Spec.TerminationGracePolicy
+	if policy == nil || !policy.SkipDrain {
+		if result, err := r.drainWorker(ctx, worker); !result.IsZero() || err != nil {
+			return result, err
+		}
+	}
+
+	logger.Info("Deleting worker process dependent resources", "name", worker.Name)
+
+	if err := r.deleteDependentResources(ctx, worker); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	controllerutil.RemoveFinalizer(worker, workerProcessDrainFinalizer)
+	if err := r.Update(ctx, worker); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// drainWorker scales the worker process Deployment down to 0 and waits for its pods to
+// terminate, optionally invoking a user-configured pre-stop hook beforehand, so running
+// workflow tasks have a chance to complete or be released back to the task queue.
+func (r *TemporalWorkerProcessReconciler) drainWorker(ctx context.Context, worker *v1beta1.TemporalWorkerProcess) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	v1beta1.SetTemporalWorkerProcessDraining(worker, metav1.ConditionTrue, v1beta1.DrainingReason, "Draining worker process before deletion")
+	if err := r.updateWorkerProcessStatus(ctx, worker); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	deployment := &appsv1.Deployment{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: worker.Namespace, Name: worker.Name}, deployment)
+	if apierrors.IsNotFound(err) {
+		return reconcile.Result{}, nil
+	}
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if deployment.Spec.Replicas == nil || *deployment.Spec.Replicas != 0 {
+		if err := r.preStopHook(ctx, worker); err != nil {
+			logger.Error(err, "Pre-stop hook failed, continuing drain")
+		}
+
+		zero := int32(0)
+		deployment.Spec.Replicas = &zero
+		if err := r.Update(ctx, deployment); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		r.Recorder.Event(worker, corev1.EventTypeNormal, v1beta1.DrainingReason, "Scaled worker process deployment to 0 replicas")
+	}
+
+	if deployment.Status.Replicas != 0 {
+		timeout := worker.Spec.TerminationGracePolicy.GetTimeout()
+		if timeout > 0 && worker.ObjectMeta.DeletionTimestamp != nil && time.Since(worker.ObjectMeta.DeletionTimestamp.Time) > timeout {
+			logger.Info("Drain timeout exceeded, proceeding with deletion despite remaining pods", "name", worker.Name, "remaining", deployment.Status.Replicas)
+			return reconcile.Result{}, nil
+		}
+
+		logger.Info("Waiting for worker process pods to terminate", "name", worker.Name, "remaining", deployment.Status.Replicas)
+		return reconcile.Result{RequeueAfter: defaultDrainPollInterval}, nil
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// preStopHook calls the user-configured HTTP pre-stop endpoint against the worker, giving it a
+// chance to gracefully release in-flight tasks before its pods are terminated.
+func (r *TemporalWorkerProcessReconciler) preStopHook(ctx context.Context, worker *v1beta1.TemporalWorkerProcess) error {
+	hook := worker.Spec.PreStopHook
+	if hook == nil || hook.HTTP == nil {
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.HTTP.URL, nil)
+	if err != nil {
+		return err
+	}
+
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// deleteDependentResources deletes the worker process' dependent resources. For a Spec.Placement
+// worker process, that's the Work object and member namespace dispatched to each remote cluster;
+// otherwise it's the local resources, in dependency order: the Deployment first so pods stop
+// referencing the configmap, then the configmap, then any leftover builder jobs.
+func (r *TemporalWorkerProcessReconciler) deleteDependentResources(ctx context.Context, worker *v1beta1.TemporalWorkerProcess) error {
+	if worker.Spec.Placement != nil {
+		return r.deleteDispatchedResources(ctx, worker)
+	}
+
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: worker.Name, Namespace: worker.Namespace}}
+	if err := r.deleteIfExists(ctx, deployment); err != nil {
+		return err
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: worker.Name + "-builder-scripts", Namespace: worker.Namespace}}
+	if err := r.deleteIfExists(ctx, configMap); err != nil {
+		return err
+	}
+
+	jobList := &batchv1.JobList{}
+	if err := r.List(ctx, jobList, client.InNamespace(worker.Namespace), client.MatchingLabels{"app.kubernetes.io/instance": worker.Name}); err != nil {
+		return err
+	}
+	for i := range jobList.Items {
+		if err := r.deleteIfExists(ctx, &jobList.Items[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *TemporalWorkerProcessReconciler) deleteIfExists(ctx context.Context, object client.Object) error {
+	err := r.Delete(ctx, object)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
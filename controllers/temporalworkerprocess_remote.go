@@ -0,0 +1,275 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/alexandrevilain/temporal-operator/api/v1beta1"
+	"github.com/alexandrevilain/temporal-operator/pkg/remoteapply"
+	"github.com/alexandrevilain/temporal-operator/pkg/resource"
+	"github.com/alexandrevilain/temporal-operator/pkg/workerprocess"
+)
+
+// reconcileRemotePlacement dispatches the worker process resources to every cluster selected by
+// Spec.Placement instead of applying them on the local cluster: each target gets a Work object
+// recording the dispatched manifests, a client built from the target's KubeconfigSecretRef is
+// used to actually apply them there, and the outcome is recorded in an AppliedWork object.
+func (r *TemporalWorkerProcessReconciler) reconcileRemotePlacement(ctx context.Context, worker *v1beta1.TemporalWorkerProcess, cluster *v1beta1.TemporalCluster) error {
+	logger := log.FromContext(ctx)
+
+	targets, err := selectDispatchTargets(worker)
+	if err != nil {
+		return err
+	}
+
+	workerProcessBuilder := workerprocess.Builder{
+		Instance: worker,
+		Cluster:  cluster,
+		Scheme:   r.Scheme,
+	}
+
+	builders, err := workerProcessBuilder.ResourceBuilders()
+	if err != nil {
+		return err
+	}
+
+	statuses := make([]v1beta1.ClusterDispatchStatus, 0, len(targets))
+	for _, target := range targets {
+		status, err := r.dispatchToCluster(ctx, worker, target, builders)
+		if err != nil {
+			return err
+		}
+		statuses = append(statuses, status)
+	}
+
+	logger.Info("Dispatched worker process to remote clusters", "count", len(statuses))
+
+	worker.Status.Clusters = statuses
+	worker.Status.Ready = allClustersReady(statuses)
+
+	if worker.Status.Ready {
+		v1beta1.SetTemporalWorkerProcessReady(worker, metav1.ConditionTrue, v1beta1.ServicesReadyReason, "")
+	} else {
+		v1beta1.SetTemporalWorkerProcessReady(worker, metav1.ConditionFalse, v1beta1.ServicesNotReadyReason, "")
+	}
+
+	return r.updateWorkerProcessStatus(ctx, worker)
+}
+
+// dispatchToCluster records the Work object dispatched to one target cluster, applies it there
+// through a client built from the target's KubeconfigSecretRef, and records the outcome in an
+// AppliedWork object.
+func (r *TemporalWorkerProcessReconciler) dispatchToCluster(ctx context.Context, worker *v1beta1.TemporalWorkerProcess, target v1beta1.ClusterDispatchTarget, builders []resource.Builder) (v1beta1.ClusterDispatchStatus, error) {
+	namespace := remoteapply.MemberNamespace(worker, target)
+	if err := r.ensureNamespace(ctx, namespace); err != nil {
+		return v1beta1.ClusterDispatchStatus{}, err
+	}
+
+	work := &v1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      remoteapply.WorkName(worker),
+			Namespace: namespace,
+		},
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, work, remoteapply.UpdateWork(worker, target, builders))
+	if err != nil {
+		return v1beta1.ClusterDispatchStatus{}, err
+	}
+
+	targetClient, err := remoteapply.ClientFor(ctx, r.Client, worker.Namespace, target, r.Scheme)
+	if err != nil {
+		return v1beta1.ClusterDispatchStatus{}, fmt.Errorf("can't build client for cluster %s: %w", target.Name, err)
+	}
+
+	if err := r.ensureNamespaceWith(ctx, targetClient, worker.Namespace); err != nil {
+		return v1beta1.ClusterDispatchStatus{}, fmt.Errorf("can't ensure namespace on cluster %s: %w", target.Name, err)
+	}
+
+	appliedResources, err := r.applyBuilders(ctx, targetClient, builders)
+	if err != nil {
+		return v1beta1.ClusterDispatchStatus{}, fmt.Errorf("can't apply resources on cluster %s: %w", target.Name, err)
+	}
+
+	appliedWork := &v1beta1.AppliedWork{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      work.Name,
+			Namespace: namespace,
+		},
+	}
+
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, appliedWork, func() error {
+		appliedWork.Spec.WorkName = work.Name
+		appliedWork.Spec.WorkNamespace = work.Namespace
+		appliedWork.Status.AppliedResources = appliedResources
+		return nil
+	})
+	if err != nil {
+		return v1beta1.ClusterDispatchStatus{}, err
+	}
+
+	status := v1beta1.ClusterDispatchStatus{
+		Name:             target.Name,
+		Ready:            appliedWork.IsHealthy(),
+		AppliedResources: int32(len(appliedWork.Status.AppliedResources)),
+	}
+	if !status.Ready {
+		status.Message = "one or more resources applied to this cluster are not healthy yet"
+	}
+
+	return status, nil
+}
+
+// applyBuilders applies every builder's resource against c, the target cluster's client, and
+// reports the outcome of each, mirroring what AppliedWorkReconciler.applyManifest does for a Work
+// object's raw manifests.
+func (r *TemporalWorkerProcessReconciler) applyBuilders(ctx context.Context, c client.Client, builders []resource.Builder) ([]v1beta1.AppliedResourceStatus, error) {
+	appliedResources := make([]v1beta1.AppliedResourceStatus, 0, len(builders))
+
+	for _, builder := range builders {
+		res, err := builder.Build()
+		if err != nil {
+			return nil, err
+		}
+
+		appliedMeta, err := r.appliedResourceMetaFor(res)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = controllerutil.CreateOrUpdate(ctx, c, res, func() error {
+			return builder.Update(res)
+		})
+		if err != nil {
+			appliedResources = append(appliedResources, v1beta1.AppliedResourceStatus{
+				AppliedResourceMeta: appliedMeta,
+				Healthy:             false,
+				Message:             err.Error(),
+			})
+			continue
+		}
+
+		appliedResources = append(appliedResources, v1beta1.AppliedResourceStatus{
+			AppliedResourceMeta: appliedMeta,
+			Healthy:             isBuiltResourceHealthy(res),
+		})
+	}
+
+	return appliedResources, nil
+}
+
+// isBuiltResourceHealthy reports whether a resource produced by a Builder is ready, using the
+// same criteria as isResourceHealthy.
+func isBuiltResourceHealthy(res client.Object) bool {
+	deployment, ok := res.(*appsv1.Deployment)
+	if !ok {
+		return true
+	}
+
+	return isDeploymentHealthy(deployment)
+}
+
+// ensureNamespace creates the per-target namespace holding the Work and AppliedWork objects if it
+// doesn't already exist.
+func (r *TemporalWorkerProcessReconciler) ensureNamespace(ctx context.Context, name string) error {
+	return r.ensureNamespaceWith(ctx, r.Client, name)
+}
+
+// ensureNamespaceWith creates the given namespace through c if it doesn't already exist, so it
+// can also be used to ensure a worker process' namespace exists on a target cluster.
+func (r *TemporalWorkerProcessReconciler) ensureNamespaceWith(ctx context.Context, c client.Client, name string) error {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	err := c.Get(ctx, client.ObjectKey{Name: name}, namespace)
+	if apierrors.IsNotFound(err) {
+		return c.Create(ctx, namespace)
+	}
+	return err
+}
+
+// selectDispatchTargets returns the clusters the worker process is dispatched to: either
+// Spec.Placement.Clusters filtered by ClusterSelector, or all of them when no selector is set.
+func selectDispatchTargets(worker *v1beta1.TemporalWorkerProcess) ([]v1beta1.ClusterDispatchTarget, error) {
+	placement := worker.Spec.Placement
+	if placement.ClusterSelector == nil {
+		return placement.Clusters, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(placement.ClusterSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cluster selector: %w", err)
+	}
+
+	targets := make([]v1beta1.ClusterDispatchTarget, 0, len(placement.Clusters))
+	for _, target := range placement.Clusters {
+		if selector.Matches(labels.Set(target.Labels)) {
+			targets = append(targets, target)
+		}
+	}
+
+	return targets, nil
+}
+
+// deleteDispatchedResources deletes the Work object and member namespace written for every
+// cluster Spec.Placement fans the worker process out to. It's part of reconcileDelete, since
+// a Work object's cross-namespace Namespace/Work pair can't carry an owner reference back to the
+// (differently-namespaced) TemporalWorkerProcess for Kubernetes' garbage collector to act on.
+func (r *TemporalWorkerProcessReconciler) deleteDispatchedResources(ctx context.Context, worker *v1beta1.TemporalWorkerProcess) error {
+	targets, err := selectDispatchTargets(worker)
+	if err != nil {
+		return err
+	}
+
+	for _, target := range targets {
+		namespace := remoteapply.MemberNamespace(worker, target)
+
+		work := &v1beta1.Work{ObjectMeta: metav1.ObjectMeta{Name: remoteapply.WorkName(worker), Namespace: namespace}}
+		if err := r.deleteIfExists(ctx, work); err != nil {
+			return err
+		}
+
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}
+		if err := r.deleteIfExists(ctx, ns); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func allClustersReady(statuses []v1beta1.ClusterDispatchStatus) bool {
+	if len(statuses) == 0 {
+		return false
+	}
+	for _, status := range statuses {
+		if !status.Ready {
+			return false
+		}
+	}
+	return true
+}
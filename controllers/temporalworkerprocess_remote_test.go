@@ -0,0 +1,113 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/alexandrevilain/temporal-operator/api/v1beta1"
+)
+
+func TestSelectDispatchTargets(t *testing.T) {
+	us := v1beta1.ClusterDispatchTarget{Name: "us", Labels: map[string]string{"region": "us"}}
+	eu := v1beta1.ClusterDispatchTarget{Name: "eu", Labels: map[string]string{"region": "eu"}}
+
+	tests := map[string]struct {
+		worker  *v1beta1.TemporalWorkerProcess
+		want    []string
+		wantErr bool
+	}{
+		"no selector dispatches to every configured cluster": {
+			worker: &v1beta1.TemporalWorkerProcess{Spec: v1beta1.TemporalWorkerProcessSpec{
+				Placement: &v1beta1.Placement{Clusters: []v1beta1.ClusterDispatchTarget{us, eu}},
+			}},
+			want: []string{"us", "eu"},
+		},
+		"selector filters clusters by label": {
+			worker: &v1beta1.TemporalWorkerProcess{Spec: v1beta1.TemporalWorkerProcessSpec{
+				Placement: &v1beta1.Placement{
+					Clusters:        []v1beta1.ClusterDispatchTarget{us, eu},
+					ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "eu"}},
+				},
+			}},
+			want: []string{"eu"},
+		},
+		"selector matching nothing dispatches nowhere": {
+			worker: &v1beta1.TemporalWorkerProcess{Spec: v1beta1.TemporalWorkerProcessSpec{
+				Placement: &v1beta1.Placement{
+					Clusters:        []v1beta1.ClusterDispatchTarget{us, eu},
+					ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "apac"}},
+				},
+			}},
+			want: nil,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := selectDispatchTargets(tt.worker)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("selectDispatchTargets() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			gotNames := make([]string, 0, len(got))
+			for _, target := range got {
+				gotNames = append(gotNames, target.Name)
+			}
+
+			if len(gotNames) != len(tt.want) {
+				t.Fatalf("selectDispatchTargets() = %v, want %v", gotNames, tt.want)
+			}
+			for i := range tt.want {
+				if gotNames[i] != tt.want[i] {
+					t.Errorf("selectDispatchTargets() = %v, want %v", gotNames, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestAllClustersReady(t *testing.T) {
+	tests := map[string]struct {
+		statuses []v1beta1.ClusterDispatchStatus
+		want     bool
+	}{
+		"no clusters dispatched to is not ready": {
+			statuses: nil,
+			want:     false,
+		},
+		"every cluster ready": {
+			statuses: []v1beta1.ClusterDispatchStatus{{Name: "us", Ready: true}, {Name: "eu", Ready: true}},
+			want:     true,
+		},
+		"one cluster not ready": {
+			statuses: []v1beta1.ClusterDispatchStatus{{Name: "us", Ready: true}, {Name: "eu", Ready: false}},
+			want:     false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := allClustersReady(tt.statuses); got != tt.want {
+				t.Errorf("allClustersReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
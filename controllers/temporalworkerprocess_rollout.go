@@ -0,0 +1,250 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/alexandrevilain/temporal-operator/api/v1beta1"
+	"github.com/alexandrevilain/temporal-operator/pkg/workerprocess"
+)
+
+// planRollout computes how the stable and, for BlueGreen/Canary strategies, secondary
+// Deployments should be sized for this reconciliation, and refreshes
+// TemporalWorkerProcessStatus.Versions so operators can observe the rollout's progress.
+func (r *TemporalWorkerProcessReconciler) planRollout(ctx context.Context, worker *v1beta1.TemporalWorkerProcess) (*workerprocess.RolloutPlan, error) {
+	strategy := worker.Spec.RolloutStrategy
+	if strategy == nil || strategy.Type == "" || strategy.Type == v1beta1.RollingUpdateRolloutStrategyType {
+		worker.Status.Versions = nil
+		return nil, nil
+	}
+
+	stable := &appsv1.Deployment{}
+	err := r.Get(ctx, client.ObjectKey{Namespace: worker.Namespace, Name: worker.Name}, stable)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	// No stable Deployment yet, or it's already running the desired version: nothing to roll
+	// out, run a single Deployment like RollingUpdate does.
+	if apierrors.IsNotFound(err) || stableImage(stable) == desiredImage(worker) {
+		worker.Status.Versions = []v1beta1.WorkerProcessVersion{
+			versionStatus(worker.Name, desiredImage(worker), stable, v1beta1.WorkerProcessVersionPhaseStable),
+		}
+		return nil, nil
+	}
+
+	plan, err := r.planSecondaryRollout(ctx, worker, stable, strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func (r *TemporalWorkerProcessReconciler) planSecondaryRollout(ctx context.Context, worker *v1beta1.TemporalWorkerProcess, stable *appsv1.Deployment, strategy *v1beta1.RolloutStrategy) (*workerprocess.RolloutPlan, error) {
+	logger := log.FromContext(ctx)
+
+	secondary := &appsv1.Deployment{}
+	err := r.Get(ctx, client.ObjectKey{Namespace: worker.Namespace, Name: workerprocess.SecondaryDeploymentName(worker)}, secondary)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	secondaryExists := err == nil
+
+	desiredReplicas := int32(1)
+	if worker.Spec.Replicas != nil {
+		desiredReplicas = *worker.Spec.Replicas
+	}
+
+	secondaryReplicas := secondaryReplicasFor(strategy, desiredReplicas, secondary, secondaryExists)
+
+	secondaryReady := secondaryExists && secondary.Status.ReadyReplicas == secondaryReplicas
+	promote := isPromotionDue(worker, strategy, secondary, secondaryExists, secondaryReady, secondaryReplicas, desiredReplicas)
+
+	phase := v1beta1.WorkerProcessVersionPhaseProgressing
+	if promote {
+		phase = v1beta1.WorkerProcessVersionPhasePromoting
+	}
+
+	if secondaryExists && isUnhealthy(secondary) {
+		logger.Info("Rolling back unhealthy worker process version", "name", worker.Name)
+		r.Recorder.Event(worker, corev1.EventTypeWarning, v1beta1.ResourcesReconciliationFailedReason, "New worker process version reported unhealthy, rolling back")
+		if err := r.Delete(ctx, secondary); err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		worker.Status.Versions = []v1beta1.WorkerProcessVersion{
+			versionStatus(worker.Name, stableImage(stable), stable, v1beta1.WorkerProcessVersionPhaseStable),
+		}
+		return nil, nil
+	}
+
+	if promote {
+		logger.Info("Promoting new worker process version", "name", worker.Name)
+		r.Recorder.Event(worker, corev1.EventTypeNormal, "RolloutPromoted", "New worker process version promoted, scaling down the previous one")
+
+		if err := r.Delete(ctx, secondary); err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+
+		worker.Status.Versions = []v1beta1.WorkerProcessVersion{
+			versionStatus(worker.Name, desiredImage(worker), stable, v1beta1.WorkerProcessVersionPhaseStable),
+		}
+
+		return &workerprocess.RolloutPlan{
+			StableReplicas: desiredReplicas,
+			Promote:        true,
+		}, nil
+	}
+
+	worker.Status.Versions = []v1beta1.WorkerProcessVersion{
+		versionStatus(worker.Name, stableImage(stable), stable, v1beta1.WorkerProcessVersionPhaseStable),
+		versionStatus(workerprocess.SecondaryDeploymentName(worker), desiredImage(worker), secondary, phase),
+	}
+
+	r.Recorder.Eventf(worker, corev1.EventTypeNormal, "RolloutProgressing", "New worker process version at %d/%d replicas", secondary.Status.ReadyReplicas, secondaryReplicas)
+
+	// BlueGreen keeps the stable Deployment at full scale until promotion; Canary shifts
+	// replicas from the stable Deployment to the secondary one.
+	stableReplicas := desiredReplicas - secondaryReplicas
+	if strategy.Type == v1beta1.BlueGreenRolloutStrategyType {
+		stableReplicas = desiredReplicas
+	}
+
+	return &workerprocess.RolloutPlan{
+		HasSecondary:      true,
+		StableReplicas:    stableReplicas,
+		SecondaryReplicas: secondaryReplicas,
+		Promote:           false,
+	}, nil
+}
+
+// secondaryReplicasFor computes how many replicas the secondary Deployment should run for the
+// current step of a BlueGreen or Canary rollout. For Canary, it walks the configured steps in
+// order, advancing to the next one once the previous step's pause has elapsed since the
+// secondary Deployment was created.
+func secondaryReplicasFor(strategy *v1beta1.RolloutStrategy, desiredReplicas int32, secondary *appsv1.Deployment, secondaryExists bool) int32 {
+	switch strategy.Type {
+	case v1beta1.BlueGreenRolloutStrategyType:
+		return desiredReplicas
+	case v1beta1.CanaryRolloutStrategyType:
+		if strategy.Canary == nil || len(strategy.Canary.Steps) == 0 {
+			return desiredReplicas
+		}
+
+		weight := strategy.Canary.Steps[0].SetWeight
+		if secondaryExists {
+			elapsed := time.Since(secondary.CreationTimestamp.Time)
+			var cumulativePause time.Duration
+			for _, step := range strategy.Canary.Steps {
+				weight = step.SetWeight
+				if step.Pause == nil {
+					break
+				}
+				cumulativePause += step.Pause.Duration
+				if elapsed < cumulativePause {
+					break
+				}
+			}
+		}
+
+		replicas := (desiredReplicas * weight) / 100
+		if replicas < 1 {
+			replicas = 1
+		}
+		return replicas
+	default:
+		return desiredReplicas
+	}
+}
+
+// isPromotionDue decides whether the secondary Deployment should take over from the stable one.
+// For Canary, that's once the ramp reached 100% of the desired replicas. For BlueGreen, it's
+// governed by the rollout's PromotionPolicy: a manual approval annotation or a readiness timeout.
+func isPromotionDue(worker *v1beta1.TemporalWorkerProcess, strategy *v1beta1.RolloutStrategy, secondary *appsv1.Deployment, secondaryExists, secondaryReady bool, secondaryReplicas, desiredReplicas int32) bool {
+	if !secondaryExists || !secondaryReady {
+		return false
+	}
+
+	if strategy.Type == v1beta1.CanaryRolloutStrategyType {
+		return secondaryReplicas >= desiredReplicas
+	}
+
+	var policy v1beta1.PromotionPolicy
+	if strategy.BlueGreen != nil {
+		policy = strategy.BlueGreen.PromotionPolicy
+	}
+
+	if policy.Manual {
+		return worker.Annotations[v1beta1.PromoteAnnotation] == "true"
+	}
+
+	if policy.ReadinessTimeout != nil {
+		return time.Since(secondary.CreationTimestamp.Time) >= policy.ReadinessTimeout.Duration
+	}
+
+	return true
+}
+
+// isUnhealthy reports whether the secondary Deployment's rollout is stuck: the Deployment
+// controller reports its Progressing condition as False.
+func isUnhealthy(secondary *appsv1.Deployment) bool {
+	for _, cond := range secondary.Status.Conditions {
+		if cond.Type == appsv1.DeploymentProgressing && cond.Status == corev1.ConditionFalse {
+			return true
+		}
+	}
+	return false
+}
+
+func stableImage(deployment *appsv1.Deployment) string {
+	if len(deployment.Spec.Template.Spec.Containers) == 0 {
+		return ""
+	}
+	return deployment.Spec.Template.Spec.Containers[0].Image
+}
+
+// desiredImage returns the worker process image the spec wants to run.
+func desiredImage(worker *v1beta1.TemporalWorkerProcess) string {
+	if len(worker.Spec.Template.Spec.Containers) == 0 {
+		return ""
+	}
+	return worker.Spec.Template.Spec.Containers[0].Image
+}
+
+func versionStatus(name, image string, deployment *appsv1.Deployment, phase v1beta1.WorkerProcessVersionPhase) v1beta1.WorkerProcessVersion {
+	version := v1beta1.WorkerProcessVersion{
+		Name:  name,
+		Image: image,
+		Phase: phase,
+	}
+	if deployment != nil {
+		if deployment.Spec.Replicas != nil {
+			version.Replicas = *deployment.Spec.Replicas
+		}
+		version.Ready = deployment.Status.ReadyReplicas
+	}
+	return version
+}
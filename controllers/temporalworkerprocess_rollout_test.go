@@ -0,0 +1,242 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/alexandrevilain/temporal-operator/api/v1beta1"
+)
+
+func TestSecondaryReplicasFor(t *testing.T) {
+	tests := map[string]struct {
+		strategy        *v1beta1.RolloutStrategy
+		desiredReplicas int32
+		secondary       *appsv1.Deployment
+		secondaryExists bool
+		want            int32
+	}{
+		"blue-green always runs at full scale": {
+			strategy:        &v1beta1.RolloutStrategy{Type: v1beta1.BlueGreenRolloutStrategyType},
+			desiredReplicas: 5,
+			secondary:       &appsv1.Deployment{},
+			secondaryExists: false,
+			want:            5,
+		},
+		"canary with no steps configured runs at full scale": {
+			strategy:        &v1beta1.RolloutStrategy{Type: v1beta1.CanaryRolloutStrategyType},
+			desiredReplicas: 5,
+			secondary:       &appsv1.Deployment{},
+			secondaryExists: false,
+			want:            5,
+		},
+		"canary before the secondary exists uses the first step's weight": {
+			strategy: &v1beta1.RolloutStrategy{
+				Type: v1beta1.CanaryRolloutStrategyType,
+				Canary: &v1beta1.CanaryRolloutStrategy{
+					Steps: []v1beta1.CanaryStep{
+						{SetWeight: 20},
+						{SetWeight: 100},
+					},
+				},
+			},
+			desiredReplicas: 10,
+			secondary:       &appsv1.Deployment{},
+			secondaryExists: false,
+			want:            2,
+		},
+		"canary rounds a sub-one replica weight up to one": {
+			strategy: &v1beta1.RolloutStrategy{
+				Type: v1beta1.CanaryRolloutStrategyType,
+				Canary: &v1beta1.CanaryRolloutStrategy{
+					Steps: []v1beta1.CanaryStep{
+						{SetWeight: 5},
+					},
+				},
+			},
+			desiredReplicas: 10,
+			secondary:       &appsv1.Deployment{},
+			secondaryExists: false,
+			want:            1,
+		},
+		"canary advances to the next step once the previous pause elapsed": {
+			strategy: &v1beta1.RolloutStrategy{
+				Type: v1beta1.CanaryRolloutStrategyType,
+				Canary: &v1beta1.CanaryRolloutStrategy{
+					Steps: []v1beta1.CanaryStep{
+						{SetWeight: 20, Pause: &metav1.Duration{Duration: time.Minute}},
+						{SetWeight: 100},
+					},
+				},
+			},
+			desiredReplicas: 10,
+			secondary: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(time.Now().Add(-2 * time.Minute))},
+			},
+			secondaryExists: true,
+			want:            10,
+		},
+		"canary stays on the current step until its pause elapses": {
+			strategy: &v1beta1.RolloutStrategy{
+				Type: v1beta1.CanaryRolloutStrategyType,
+				Canary: &v1beta1.CanaryRolloutStrategy{
+					Steps: []v1beta1.CanaryStep{
+						{SetWeight: 20, Pause: &metav1.Duration{Duration: time.Hour}},
+						{SetWeight: 100},
+					},
+				},
+			},
+			desiredReplicas: 10,
+			secondary: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(time.Now())},
+			},
+			secondaryExists: true,
+			want:            2,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := secondaryReplicasFor(tt.strategy, tt.desiredReplicas, tt.secondary, tt.secondaryExists)
+			if got != tt.want {
+				t.Errorf("secondaryReplicasFor() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsPromotionDue(t *testing.T) {
+	canary := &v1beta1.RolloutStrategy{Type: v1beta1.CanaryRolloutStrategyType}
+	blueGreenManual := &v1beta1.RolloutStrategy{
+		Type:      v1beta1.BlueGreenRolloutStrategyType,
+		BlueGreen: &v1beta1.BlueGreenRolloutStrategy{PromotionPolicy: v1beta1.PromotionPolicy{Manual: true}},
+	}
+	blueGreenTimeout := &v1beta1.RolloutStrategy{
+		Type: v1beta1.BlueGreenRolloutStrategyType,
+		BlueGreen: &v1beta1.BlueGreenRolloutStrategy{
+			PromotionPolicy: v1beta1.PromotionPolicy{ReadinessTimeout: &metav1.Duration{Duration: time.Minute}},
+		},
+	}
+	blueGreenAuto := &v1beta1.RolloutStrategy{Type: v1beta1.BlueGreenRolloutStrategyType}
+
+	tests := map[string]struct {
+		worker            *v1beta1.TemporalWorkerProcess
+		strategy          *v1beta1.RolloutStrategy
+		secondary         *appsv1.Deployment
+		secondaryExists   bool
+		secondaryReady    bool
+		secondaryReplicas int32
+		desiredReplicas   int32
+		want              bool
+	}{
+		"not due when the secondary doesn't exist yet": {
+			worker:          &v1beta1.TemporalWorkerProcess{},
+			strategy:        canary,
+			secondary:       &appsv1.Deployment{},
+			secondaryExists: false,
+			want:            false,
+		},
+		"not due when the secondary isn't ready yet": {
+			worker:          &v1beta1.TemporalWorkerProcess{},
+			strategy:        canary,
+			secondary:       &appsv1.Deployment{},
+			secondaryExists: true,
+			secondaryReady:  false,
+			want:            false,
+		},
+		"canary is due once it reached full scale": {
+			worker:            &v1beta1.TemporalWorkerProcess{},
+			strategy:          canary,
+			secondary:         &appsv1.Deployment{},
+			secondaryExists:   true,
+			secondaryReady:    true,
+			secondaryReplicas: 10,
+			desiredReplicas:   10,
+			want:              true,
+		},
+		"canary is not due before it reached full scale": {
+			worker:            &v1beta1.TemporalWorkerProcess{},
+			strategy:          canary,
+			secondary:         &appsv1.Deployment{},
+			secondaryExists:   true,
+			secondaryReady:    true,
+			secondaryReplicas: 2,
+			desiredReplicas:   10,
+			want:              false,
+		},
+		"blue-green manual waits for the promote annotation": {
+			worker:          &v1beta1.TemporalWorkerProcess{},
+			strategy:        blueGreenManual,
+			secondary:       &appsv1.Deployment{},
+			secondaryExists: true,
+			secondaryReady:  true,
+			want:            false,
+		},
+		"blue-green manual promotes once the annotation is set": {
+			worker: &v1beta1.TemporalWorkerProcess{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{v1beta1.PromoteAnnotation: "true"}},
+			},
+			strategy:        blueGreenManual,
+			secondary:       &appsv1.Deployment{},
+			secondaryExists: true,
+			secondaryReady:  true,
+			want:            true,
+		},
+		"blue-green readiness timeout not yet elapsed": {
+			worker:   &v1beta1.TemporalWorkerProcess{},
+			strategy: blueGreenTimeout,
+			secondary: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(time.Now())},
+			},
+			secondaryExists: true,
+			secondaryReady:  true,
+			want:            false,
+		},
+		"blue-green readiness timeout elapsed": {
+			worker:   &v1beta1.TemporalWorkerProcess{},
+			strategy: blueGreenTimeout,
+			secondary: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(time.Now().Add(-2 * time.Minute))},
+			},
+			secondaryExists: true,
+			secondaryReady:  true,
+			want:            true,
+		},
+		"blue-green with no promotion policy promotes as soon as ready": {
+			worker:          &v1beta1.TemporalWorkerProcess{},
+			strategy:        blueGreenAuto,
+			secondary:       &appsv1.Deployment{},
+			secondaryExists: true,
+			secondaryReady:  true,
+			want:            true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := isPromotionDue(tt.worker, tt.strategy, tt.secondary, tt.secondaryExists, tt.secondaryReady, tt.secondaryReplicas, tt.desiredReplicas)
+			if got != tt.want {
+				t.Errorf("isPromotionDue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
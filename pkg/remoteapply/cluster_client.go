@@ -0,0 +1,56 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package remoteapply
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/alexandrevilain/temporal-operator/api/v1beta1"
+)
+
+// kubeconfigSecretKey is the key, within the referenced Secret, holding the target cluster's
+// kubeconfig.
+const kubeconfigSecretKey = "kubeconfig"
+
+// ClientFor returns a client.Client for the cluster referenced by the given dispatch target,
+// built from the kubeconfig stored in KubeconfigSecretRef, in the local cluster's namespace.
+func ClientFor(ctx context.Context, local client.Client, namespace string, target v1beta1.ClusterDispatchTarget, scheme *runtime.Scheme) (client.Client, error) {
+	secret := &corev1.Secret{}
+	err := local.Get(ctx, client.ObjectKey{Namespace: namespace, Name: target.KubeconfigSecretRef.Name}, secret)
+	if err != nil {
+		return nil, fmt.Errorf("can't get kubeconfig secret for cluster %s: %w", target.Name, err)
+	}
+
+	kubeconfig, ok := secret.Data[kubeconfigSecretKey]
+	if !ok {
+		return nil, fmt.Errorf("secret %s doesn't contain a %q key", target.KubeconfigSecretRef.Name, kubeconfigSecretKey)
+	}
+
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("can't build rest config for cluster %s: %w", target.Name, err)
+	}
+
+	return client.New(restConfig, client.Options{Scheme: scheme})
+}
@@ -0,0 +1,105 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package remoteapply dispatches a TemporalWorkerProcess's resources to remote clusters: for each
+// target, a Work object records the manifest bundle dispatched to it, a client built from its
+// KubeconfigSecretRef is used to actually apply those manifests against the target cluster, and
+// the outcome is recorded in an AppliedWork object.
+package remoteapply
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/alexandrevilain/temporal-operator/api/v1beta1"
+	"github.com/alexandrevilain/temporal-operator/pkg/resource"
+)
+
+// MemberNamespace is the namespace, on the operator's own cluster, holding the Work and
+// AppliedWork objects dispatched to the given target cluster on behalf of the worker process. It's
+// scoped by both the worker process' name and namespace so two worker processes dispatching to
+// the same target cluster don't share, and can't clobber, each other's records.
+func MemberNamespace(worker *v1beta1.TemporalWorkerProcess, target v1beta1.ClusterDispatchTarget) string {
+	return worker.Namespace + "-" + worker.Name + "-" + target.Name
+}
+
+// WorkName is the name of the Work object dispatched to the given target cluster.
+func WorkName(worker *v1beta1.TemporalWorkerProcess) string {
+	return worker.Name
+}
+
+// BuildWork renders the resources produced by builders into a Work object targeting the given
+// cluster. Resources are marshaled as-is, as a record of what's dispatched to the target cluster;
+// the caller is responsible for actually applying them there, using a client built with ClientFor.
+func BuildWork(worker *v1beta1.TemporalWorkerProcess, target v1beta1.ClusterDispatchTarget, builders []resource.Builder) (*v1beta1.Work, error) {
+	manifests := make([]runtime.RawExtension, 0, len(builders))
+
+	for _, builder := range builders {
+		res, err := builder.Build()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := builder.Update(res); err != nil {
+			return nil, err
+		}
+
+		raw, err := json.Marshal(res)
+		if err != nil {
+			return nil, err
+		}
+
+		manifests = append(manifests, runtime.RawExtension{Raw: raw})
+	}
+
+	return &v1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      WorkName(worker),
+			Namespace: MemberNamespace(worker, target),
+			Labels: map[string]string{
+				"temporal.io/worker-process":           worker.Name,
+				"temporal.io/worker-process-namespace": worker.Namespace,
+			},
+		},
+		Spec: v1beta1.WorkSpec{
+			Workload: v1beta1.WorkloadTemplate{
+				Manifests: manifests,
+			},
+		},
+	}, nil
+}
+
+// UpdateWork mutates an existing Work object to match the resources produced by builders. It's
+// meant to be used as the mutate function of controllerutil.CreateOrUpdate.
+func UpdateWork(worker *v1beta1.TemporalWorkerProcess, target v1beta1.ClusterDispatchTarget, builders []resource.Builder) func(client.Object) error {
+	return func(object client.Object) error {
+		work := object.(*v1beta1.Work)
+
+		built, err := BuildWork(worker, target, builders)
+		if err != nil {
+			return err
+		}
+
+		work.Labels = built.Labels
+		work.Spec = built.Spec
+
+		return nil
+	}
+}
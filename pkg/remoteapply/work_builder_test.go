@@ -0,0 +1,40 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package remoteapply
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/alexandrevilain/temporal-operator/api/v1beta1"
+)
+
+func TestMemberNamespaceIsScopedByWorkerName(t *testing.T) {
+	target := v1beta1.ClusterDispatchTarget{Name: "eu"}
+
+	workerA := &v1beta1.TemporalWorkerProcess{ObjectMeta: metav1.ObjectMeta{Name: "worker-a", Namespace: "default"}}
+	workerB := &v1beta1.TemporalWorkerProcess{ObjectMeta: metav1.ObjectMeta{Name: "worker-b", Namespace: "default"}}
+
+	nsA := MemberNamespace(workerA, target)
+	nsB := MemberNamespace(workerB, target)
+
+	if nsA == nsB {
+		t.Fatalf("expected different worker processes dispatching to the same target to get distinct member namespaces, both got %q", nsA)
+	}
+}
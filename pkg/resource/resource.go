@@ -0,0 +1,81 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package resource provides the builder interfaces shared by every resource
+// builder of the operator (deployments, configmaps, jobs, ...).
+package resource
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/alexandrevilain/temporal-operator/api/v1beta1"
+)
+
+// Builder is implemented by every type able to build and update a kubernetes resource.
+type Builder interface {
+	Build() (client.Object, error)
+	Update(client.Object) error
+}
+
+// Comparer can be implemented by a Builder to provide a custom semantic equality function
+// used by controllerutil.CreateOrUpdate to detect unnecessary updates.
+type Comparer interface {
+	Equal(x, y interface{}) bool
+}
+
+// WorkerProcessDeploymentReporter can be implemented by a Builder to report the readiness
+// of the deployment it manages.
+type WorkerProcessDeploymentReporter interface {
+	ReportWorkerDeploymentStatus(ctx context.Context, client client.Client) (bool, error)
+}
+
+// WorkerProcessJob describes one step of the worker process build pipeline.
+type WorkerProcessJob struct {
+	// Name identifies the job step (e.g. "build").
+	Name string
+	// Command is the command run by the job to produce the worker process image.
+	Command []string
+	// Skip returns true if this job step should be skipped for the given worker process.
+	Skip func(worker *v1beta1.TemporalWorkerProcess) bool
+	// ReportSuccess is called once the job has succeeded, to let the step record progress
+	// on the worker process object.
+	ReportSuccess func(worker *v1beta1.TemporalWorkerProcess) error
+}
+
+// GetWorkerProcessJobs returns the ordered list of jobs used to build a worker process image.
+func GetWorkerProcessJobs() []WorkerProcessJob {
+	const buildJobName = "build"
+
+	return []WorkerProcessJob{
+		{
+			Name:    buildJobName,
+			Command: []string{"build"},
+			Skip: func(worker *v1beta1.TemporalWorkerProcess) bool {
+				return !worker.Spec.Builder.BuilderEnabled()
+			},
+			ReportSuccess: func(worker *v1beta1.TemporalWorkerProcess) error {
+				if worker.Status.BuiltJobs == nil {
+					worker.Status.BuiltJobs = map[string]int64{}
+				}
+				worker.Status.BuiltJobs[buildJobName] = worker.Generation
+				return nil
+			},
+		},
+	}
+}
@@ -0,0 +1,71 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package workerbuilder builds the resources involved in building a worker process image.
+package workerbuilder
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/alexandrevilain/temporal-operator/api/v1beta1"
+)
+
+// BuilderScriptsConfigmapBuilder builds the configmap holding the scripts used by the
+// worker process builder job.
+type BuilderScriptsConfigmapBuilder struct {
+	instance *v1beta1.TemporalWorkerProcess
+	scheme   *runtime.Scheme
+}
+
+// NewBuilderScriptsConfigmapBuilder creates a new BuilderScriptsConfigmapBuilder.
+func NewBuilderScriptsConfigmapBuilder(instance *v1beta1.TemporalWorkerProcess, scheme *runtime.Scheme) *BuilderScriptsConfigmapBuilder {
+	return &BuilderScriptsConfigmapBuilder{
+		instance: instance,
+		scheme:   scheme,
+	}
+}
+
+// Build returns the empty configmap object, ready to be filled by Update.
+func (b *BuilderScriptsConfigmapBuilder) Build() (client.Object, error) {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      b.instance.Name + "-builder-scripts",
+			Namespace: b.instance.Namespace,
+		},
+	}, nil
+}
+
+// Update fills the given configmap with the builder scripts content and sets the owner reference.
+func (b *BuilderScriptsConfigmapBuilder) Update(object client.Object) error {
+	configMap := object.(*corev1.ConfigMap)
+
+	if configMap.Data == nil {
+		configMap.Data = make(map[string]string)
+	}
+	configMap.Data["build.sh"] = buildScript
+
+	return controllerutil.SetControllerReference(b.instance, configMap, b.scheme)
+}
+
+const buildScript = `#!/bin/sh
+set -e
+echo "building worker process image"
+`
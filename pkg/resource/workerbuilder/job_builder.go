@@ -0,0 +1,102 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package workerbuilder
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/alexandrevilain/temporal-operator/api/v1beta1"
+)
+
+// WorkerProcessJobBuilder builds the job used to build a worker process image.
+type WorkerProcessJobBuilder struct {
+	instance *v1beta1.TemporalWorkerProcess
+	scheme   *runtime.Scheme
+	jobName  string
+	command  []string
+}
+
+// NewWorkerProcessJobBuilder creates a new WorkerProcessJobBuilder for the given job step.
+func NewWorkerProcessJobBuilder(instance *v1beta1.TemporalWorkerProcess, scheme *runtime.Scheme, jobName string, command []string) *WorkerProcessJobBuilder {
+	return &WorkerProcessJobBuilder{
+		instance: instance,
+		scheme:   scheme,
+		jobName:  jobName,
+		command:  command,
+	}
+}
+
+// Build returns the empty job object, ready to be filled by Update.
+func (b *WorkerProcessJobBuilder) Build() (client.Object, error) {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      b.instance.Name + "-" + b.jobName,
+			Namespace: b.instance.Namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/instance": b.instance.Name,
+			},
+		},
+	}, nil
+}
+
+// Update fills the given job with the worker process builder pod spec and sets the owner reference.
+func (b *WorkerProcessJobBuilder) Update(object client.Object) error {
+	job := object.(*batchv1.Job)
+
+	template := b.jobTemplate()
+
+	container := corev1.Container{
+		Name:         b.jobName,
+		Image:        b.instance.Spec.Builder.Image,
+		Command:      b.command,
+		Resources:    template.Resources,
+		Env:          template.Env,
+		VolumeMounts: template.VolumeMounts,
+	}
+
+	job.Spec.TTLSecondsAfterFinished = pointer.Int32(template.GetTTLSecondsAfterFinished())
+	job.Spec.BackoffLimit = template.BackoffLimit
+	job.Spec.ActiveDeadlineSeconds = template.ActiveDeadlineSeconds
+	job.Spec.Template.Spec = corev1.PodSpec{
+		RestartPolicy:    corev1.RestartPolicyNever,
+		Containers:       []corev1.Container{container},
+		Volumes:          template.Volumes,
+		NodeSelector:     template.NodeSelector,
+		Tolerations:      template.Tolerations,
+		Affinity:         template.Affinity,
+		ImagePullSecrets: template.ImagePullSecrets,
+	}
+
+	return controllerutil.SetControllerReference(b.instance, job, b.scheme)
+}
+
+// jobTemplate returns the BuilderJobTemplate configured for this job step, or an empty one if
+// the worker process doesn't override it.
+func (b *WorkerProcessJobBuilder) jobTemplate() *v1beta1.BuilderJobTemplate {
+	template, ok := b.instance.Spec.Builder.JobTemplates[b.jobName]
+	if !ok {
+		return &v1beta1.BuilderJobTemplate{}
+	}
+	return &template
+}
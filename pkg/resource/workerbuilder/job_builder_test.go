@@ -0,0 +1,91 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package workerbuilder
+
+import (
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+
+	"github.com/alexandrevilain/temporal-operator/api/v1beta1"
+)
+
+func TestWorkerProcessJobBuilderUpdateAppliesJobTemplate(t *testing.T) {
+	tests := map[string]struct {
+		jobTemplates map[string]v1beta1.BuilderJobTemplate
+		wantTTL      int32
+	}{
+		"no job template override uses the default TTL": {
+			jobTemplates: nil,
+			wantTTL:      3600,
+		},
+		"job template override wins over the default TTL": {
+			jobTemplates: map[string]v1beta1.BuilderJobTemplate{
+				"build": {TTLSecondsAfterFinished: pointer.Int32(60)},
+			},
+			wantTTL: 60,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			instance := &v1beta1.TemporalWorkerProcess{
+				Spec: v1beta1.TemporalWorkerProcessSpec{
+					Builder: v1beta1.WorkerProcessBuilder{
+						JobTemplates: tt.jobTemplates,
+					},
+				},
+			}
+
+			b := NewWorkerProcessJobBuilder(instance, runtime.NewScheme(), "build", []string{"build"})
+
+			object, err := b.Build()
+			if err != nil {
+				t.Fatalf("Build() returned an error: %v", err)
+			}
+
+			// SetControllerReference may fail against an empty scheme; the job spec is set
+			// before that call either way, which is all this test cares about.
+			_ = b.Update(object)
+
+			job := object.(*batchv1.Job)
+			if job.Spec.TTLSecondsAfterFinished == nil || *job.Spec.TTLSecondsAfterFinished != tt.wantTTL {
+				t.Errorf("Spec.TTLSecondsAfterFinished = %v, want %d", job.Spec.TTLSecondsAfterFinished, tt.wantTTL)
+			}
+		})
+	}
+}
+
+func TestWorkerProcessJobBuilderBuildLabelsTheJobForCleanup(t *testing.T) {
+	instance := &v1beta1.TemporalWorkerProcess{}
+	instance.Name = "worker"
+
+	b := NewWorkerProcessJobBuilder(instance, runtime.NewScheme(), "build", []string{"build"})
+
+	object, err := b.Build()
+	if err != nil {
+		t.Fatalf("Build() returned an error: %v", err)
+	}
+
+	got := object.(*batchv1.Job).Labels["app.kubernetes.io/instance"]
+	if got != instance.Name {
+		t.Errorf("Labels[app.kubernetes.io/instance] = %q, want %q", got, instance.Name)
+	}
+}
@@ -0,0 +1,62 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package workerprocess builds the resources required to run a TemporalWorkerProcess.
+package workerprocess
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/alexandrevilain/temporal-operator/api/v1beta1"
+	"github.com/alexandrevilain/temporal-operator/pkg/resource"
+)
+
+// Builder builds the resources required to run a TemporalWorkerProcess against a TemporalCluster.
+type Builder struct {
+	Instance *v1beta1.TemporalWorkerProcess
+	Cluster  *v1beta1.TemporalCluster
+	Scheme   *runtime.Scheme
+	// Rollout is the plan computed for the current reconciliation by the controller. It's nil
+	// when the worker process uses the default RollingUpdate strategy.
+	Rollout *RolloutPlan
+}
+
+// ResourceBuilders returns the ordered list of builders used to reconcile the worker process resources.
+func (b *Builder) ResourceBuilders() ([]resource.Builder, error) {
+	hasSecondary := b.Rollout != nil && b.Rollout.HasSecondary
+	pinExistingImage := hasSecondary && !b.Rollout.Promote
+
+	builders := []resource.Builder{
+		NewDeploymentBuilder(b.Instance, b.Cluster, b.Scheme, b.stableReplicas(), pinExistingImage),
+	}
+
+	if hasSecondary && !b.Rollout.Promote {
+		builders = append(builders, NewSecondaryDeploymentBuilder(b.Instance, b.Cluster, b.Scheme, b.Rollout.SecondaryReplicas))
+	}
+
+	return builders, nil
+}
+
+// stableReplicas returns the number of replicas the stable Deployment should run, falling back
+// to the spec when no rollout is in progress.
+func (b *Builder) stableReplicas() *int32 {
+	if b.Rollout == nil {
+		return nil
+	}
+	replicas := b.Rollout.StableReplicas
+	return &replicas
+}
@@ -0,0 +1,130 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package workerprocess
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/alexandrevilain/temporal-operator/api/v1beta1"
+)
+
+// DeploymentBuilder builds the Deployment running the stable worker process version.
+type DeploymentBuilder struct {
+	instance         *v1beta1.TemporalWorkerProcess
+	cluster          *v1beta1.TemporalCluster
+	scheme           *runtime.Scheme
+	replicasOverride *int32
+	pinExistingImage bool
+}
+
+// NewDeploymentBuilder creates a new DeploymentBuilder. replicasOverride, when non-nil, takes
+// precedence over Spec.Replicas; it's set by the controller during a BlueGreen rollout, which
+// keeps the stable Deployment at full scale, and during a Canary rollout, which ramps it down in
+// favor of the secondary one. pinExistingImage, when true,
+// leaves the Deployment's currently running pod template untouched instead of rolling it forward
+// to Spec.Template, so the stable version keeps serving until the rollout is promoted.
+func NewDeploymentBuilder(instance *v1beta1.TemporalWorkerProcess, cluster *v1beta1.TemporalCluster, scheme *runtime.Scheme, replicasOverride *int32, pinExistingImage bool) *DeploymentBuilder {
+	return &DeploymentBuilder{
+		instance:         instance,
+		cluster:          cluster,
+		scheme:           scheme,
+		replicasOverride: replicasOverride,
+		pinExistingImage: pinExistingImage,
+	}
+}
+
+// Build returns the empty deployment object, ready to be filled by Update.
+func (b *DeploymentBuilder) Build() (client.Object, error) {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      b.instance.Name,
+			Namespace: b.instance.Namespace,
+		},
+	}, nil
+}
+
+// Update fills the given deployment with the worker process pod spec and sets the owner reference.
+func (b *DeploymentBuilder) Update(object client.Object) error {
+	deployment := object.(*appsv1.Deployment)
+
+	replicas := b.instance.Spec.Replicas
+	if b.replicasOverride != nil {
+		replicas = b.replicasOverride
+	}
+	if b.instance.IsDeploymentSuspended() {
+		zero := int32(0)
+		replicas = &zero
+	}
+
+	// While a BlueGreen or Canary rollout is in progress and not yet promoted, the stable
+	// Deployment keeps running whatever pod template it already has: only its replica count is
+	// adjusted to make room for the secondary Deployment.
+	if b.pinExistingImage && len(deployment.Spec.Template.Spec.Containers) > 0 {
+		deployment.Spec.Replicas = replicas
+		return controllerutil.SetControllerReference(b.instance, deployment, b.scheme)
+	}
+
+	applyWorkerProcessPodSpec(b.instance, deployment, b.instance.Name, replicas)
+
+	return controllerutil.SetControllerReference(b.instance, deployment, b.scheme)
+}
+
+// ReportWorkerDeploymentStatus reports whether the worker process deployment is ready.
+func (b *DeploymentBuilder) ReportWorkerDeploymentStatus(ctx context.Context, c client.Client) (bool, error) {
+	deployment := &appsv1.Deployment{}
+	err := c.Get(ctx, client.ObjectKey{Namespace: b.instance.Namespace, Name: b.instance.Name}, deployment)
+	if err != nil {
+		return false, err
+	}
+
+	if b.instance.IsDeploymentSuspended() {
+		return true, nil
+	}
+
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+
+	return deployment.Status.ReadyReplicas == desired, nil
+}
+
+// applyWorkerProcessPodSpec fills a Deployment with the worker process pod template, labelling
+// it so its pods are selected by name, letting two Deployments (stable and secondary) coexist and
+// poll the same Temporal task queue during a BlueGreen or Canary rollout.
+func applyWorkerProcessPodSpec(instance *v1beta1.TemporalWorkerProcess, deployment *appsv1.Deployment, name string, replicas *int32) {
+	selector := metav1.SetAsLabelSelector(map[string]string{
+		"app.kubernetes.io/instance": name,
+	})
+
+	deployment.Spec.Replicas = replicas
+	deployment.Spec.Selector = selector
+	deployment.Spec.Template = instance.Spec.Template
+	if deployment.Spec.Template.Labels == nil {
+		deployment.Spec.Template.Labels = map[string]string{}
+	}
+	for k, v := range selector.MatchLabels {
+		deployment.Spec.Template.Labels[k] = v
+	}
+}
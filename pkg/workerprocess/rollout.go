@@ -0,0 +1,46 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package workerprocess
+
+import "github.com/alexandrevilain/temporal-operator/api/v1beta1"
+
+// secondaryDeploymentSuffix names the Deployment holding the new version during a BlueGreen or
+// Canary rollout, alongside the stable one.
+const secondaryDeploymentSuffix = "-canary"
+
+// RolloutPlan describes how many replicas the stable and, if any, secondary (new version)
+// Deployments should run for the current reconciliation. It's computed once per reconcile by
+// the controller and handed to the Builder so every resource builder agrees on the same state.
+type RolloutPlan struct {
+	// HasSecondary is true when a BlueGreen or Canary rollout is in progress and a secondary
+	// Deployment must be reconciled alongside the stable one.
+	HasSecondary bool
+	// StableReplicas is the number of replicas the stable Deployment should run.
+	StableReplicas int32
+	// SecondaryReplicas is the number of replicas the secondary Deployment should run.
+	SecondaryReplicas int32
+	// Promote is true once the secondary version met its promotion policy: the stable
+	// Deployment's template should switch to the new version and the secondary be scaled down.
+	Promote bool
+}
+
+// SecondaryDeploymentName returns the name of the secondary Deployment used by BlueGreen and
+// Canary rollouts for the given worker process.
+func SecondaryDeploymentName(instance *v1beta1.TemporalWorkerProcess) string {
+	return instance.Name + secondaryDeploymentSuffix
+}
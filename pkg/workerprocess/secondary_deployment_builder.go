@@ -0,0 +1,94 @@
+// Licensed to Alexandre VILAIN under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Alexandre VILAIN licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package workerprocess
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/alexandrevilain/temporal-operator/api/v1beta1"
+)
+
+// SecondaryDeploymentBuilder builds the Deployment running the new worker process version during
+// a BlueGreen or Canary rollout, alongside the stable Deployment built by DeploymentBuilder.
+type SecondaryDeploymentBuilder struct {
+	instance *v1beta1.TemporalWorkerProcess
+	cluster  *v1beta1.TemporalCluster
+	scheme   *runtime.Scheme
+	replicas int32
+}
+
+// NewSecondaryDeploymentBuilder creates a new SecondaryDeploymentBuilder.
+func NewSecondaryDeploymentBuilder(instance *v1beta1.TemporalWorkerProcess, cluster *v1beta1.TemporalCluster, scheme *runtime.Scheme, replicas int32) *SecondaryDeploymentBuilder {
+	return &SecondaryDeploymentBuilder{
+		instance: instance,
+		cluster:  cluster,
+		scheme:   scheme,
+		replicas: replicas,
+	}
+}
+
+// Build returns the empty deployment object, ready to be filled by Update.
+func (b *SecondaryDeploymentBuilder) Build() (client.Object, error) {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      SecondaryDeploymentName(b.instance),
+			Namespace: b.instance.Namespace,
+		},
+	}, nil
+}
+
+// Update fills the given deployment with the worker process pod spec and sets the owner reference.
+func (b *SecondaryDeploymentBuilder) Update(object client.Object) error {
+	deployment := object.(*appsv1.Deployment)
+
+	replicas := b.replicas
+	if b.instance.IsDeploymentSuspended() {
+		zero := int32(0)
+		replicas = zero
+	}
+
+	applyWorkerProcessPodSpec(b.instance, deployment, SecondaryDeploymentName(b.instance), &replicas)
+
+	return controllerutil.SetControllerReference(b.instance, deployment, b.scheme)
+}
+
+// ReportWorkerDeploymentStatus reports whether the secondary worker process deployment is ready.
+func (b *SecondaryDeploymentBuilder) ReportWorkerDeploymentStatus(ctx context.Context, c client.Client) (bool, error) {
+	deployment := &appsv1.Deployment{}
+	err := c.Get(ctx, client.ObjectKey{Namespace: b.instance.Namespace, Name: SecondaryDeploymentName(b.instance)}, deployment)
+	if err != nil {
+		return false, err
+	}
+
+	if b.instance.IsDeploymentSuspended() {
+		return true, nil
+	}
+
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+
+	return deployment.Status.ReadyReplicas == desired, nil
+}